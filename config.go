@@ -2,15 +2,32 @@ package slate
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"math"
 	"net/http"
 	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
 	"reflect"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
+	"text/template"
 	"time"
 
 	"github.com/spf13/afero"
@@ -93,6 +110,16 @@ const (
 	// present in a directory (optionally recursive).
 	ConfigDirSourceCreatorContainerID = ConfigSupplierCreatorTag + ".dir"
 
+	// ConfigEmbeddedFileSourceCreatorContainerID defines the id of a
+	// config supplier service that retrieves the config data from a file
+	// of a fs.FS (like an embed.FS) registered in the container.
+	ConfigEmbeddedFileSourceCreatorContainerID = ConfigSupplierCreatorTag + ".embedded-file"
+
+	// ConfigEmbeddedDirSourceCreatorContainerID defines the id of a
+	// config supplier service that retrieves the config data from a
+	// directory of a fs.FS (like an embed.FS) registered in the container.
+	ConfigEmbeddedDirSourceCreatorContainerID = ConfigSupplierCreatorTag + ".embedded-dir"
+
 	// ConfigRestSourceCreatorContainerID defines the id of a config
 	// supplier service that retrieves the config data from a REST
 	// web service.
@@ -103,6 +130,11 @@ const (
 	// web service that will be periodically observed for changes.
 	ConfigObsRestSourceCreatorContainerID = ConfigSupplierCreatorTag + ".obs-rest"
 
+	// ConfigCommandSourceCreatorContainerID defines the id of a config
+	// supplier service that retrieves the config data from the output
+	// of a local executable that will be periodically re-executed.
+	ConfigCommandSourceCreatorContainerID = ConfigSupplierCreatorTag + ".command"
+
 	// ConfigAllSupplierCreatorsContainerID defines the id for an aggregation
 	// of all registered services that are tagged with the config supplier tag.
 	ConfigAllSupplierCreatorsContainerID = ConfigSupplierCreatorTag + ".all"
@@ -147,6 +179,14 @@ const (
 	// dir config supplier type.
 	ConfigTypeDir = "dir"
 
+	// ConfigTypeEmbeddedFile defines the value to be used to declare a
+	// file config supplier type that reads from a registered fs.FS.
+	ConfigTypeEmbeddedFile = "embedded-file"
+
+	// ConfigTypeEmbeddedDir defines the value to be used to declare a
+	// dir config supplier type that reads from a registered fs.FS.
+	ConfigTypeEmbeddedDir = "embedded-dir"
+
 	// ConfigTypeRest defines the value to be used to declare a
 	// REST config supplier type.
 	ConfigTypeRest = "rest"
@@ -154,6 +194,77 @@ const (
 	// ConfigTypeObsRest defines the value to be used to
 	// declare an observable REST config supplier type.
 	ConfigTypeObsRest = "observable-rest"
+
+	// ConfigTypeCommand defines the value to be used to declare an
+	// (observable) command output config supplier type.
+	ConfigTypeCommand = "command"
+
+	// ConfigMergeReplace defines the value to be used to declare a list
+	// merge strategy where the higher priority list replaces the
+	// lower priority one.
+	ConfigMergeReplace = "replace"
+
+	// ConfigMergeAppend defines the value to be used to declare a list
+	// merge strategy where the higher priority list is appended to the
+	// lower priority one.
+	ConfigMergeAppend = "append"
+
+	// ConfigMergePrepend defines the value to be used to declare a list
+	// merge strategy where the higher priority list is prepended to the
+	// lower priority one.
+	ConfigMergePrepend = "prepend"
+
+	// ConfigMergeByKey defines the value to be used to declare a list
+	// merge strategy where the higher priority list elements are merged
+	// with the lower priority list elements identified by the same key.
+	ConfigMergeByKey = "merge-by-key"
+
+	// ConfigCaseLower defines the value to be used to declare a config
+	// keys case policy where all the keys are lowercased.
+	ConfigCaseLower = "lower"
+
+	// ConfigCasePreserve defines the value to be used to declare a config
+	// keys case policy where the keys case is preserved and the lookups
+	// are case-sensitive.
+	ConfigCasePreserve = "preserve"
+
+	// ConfigCaseInsensitive defines the value to be used to declare a
+	// config keys case policy where the original keys case is preserved
+	// but the lookups are case-insensitive.
+	ConfigCaseInsensitive = "insensitive"
+
+	// ConfigRestAuthBearer defines the value to be used to declare a
+	// REST config supplier bearer token authentication.
+	ConfigRestAuthBearer = "bearer"
+
+	// ConfigRestAuthBasic defines the value to be used to declare a
+	// REST config supplier basic authentication.
+	ConfigRestAuthBasic = "basic"
+
+	// ConfigRestTimestampRFC3339 defines the value to be used to declare
+	// an observable REST config supplier change detection by a RFC3339
+	// timestamp string.
+	ConfigRestTimestampRFC3339 = "rfc3339"
+
+	// ConfigRestTimestampEpoch defines the value to be used to declare
+	// an observable REST config supplier change detection by a unix
+	// epoch timestamp in seconds.
+	ConfigRestTimestampEpoch = "epoch"
+
+	// ConfigRestTimestampEpochMillis defines the value to be used to
+	// declare an observable REST config supplier change detection by a
+	// unix epoch timestamp in milliseconds.
+	ConfigRestTimestampEpochMillis = "epoch-ms"
+
+	// ConfigRestTimestampVersion defines the value to be used to declare
+	// an observable REST config supplier change detection by a
+	// monotonically increasing version number.
+	ConfigRestTimestampVersion = "version"
+
+	// ConfigRestTimestampHash defines the value to be used to declare
+	// an observable REST config supplier change detection by the hash
+	// of the config section content.
+	ConfigRestTimestampHash = "hash"
 )
 
 var (
@@ -165,6 +276,28 @@ var (
 	// format if the format is not present in the config.
 	ConfigDefaultRestFormat = EnvString(ConfigEnvID+"_DEFAULT_REST_FORMAT", "json")
 
+	// ConfigDefaultRestTimestamp defines the observable rest config
+	// supplier change detection strategy if the strategy is not present
+	// in the config.
+	ConfigDefaultRestTimestamp = EnvString(ConfigEnvID+"_DEFAULT_REST_TIMESTAMP", "rfc3339")
+
+	// ConfigRestRetryMinDelay defines the minimum delay, in milliseconds,
+	// between the REST config supplier request attempts.
+	ConfigRestRetryMinDelay = EnvInt(ConfigEnvID+"_REST_RETRY_MIN_DELAY", 100)
+
+	// ConfigRestRetryMaxDelay defines the maximum delay, in milliseconds,
+	// between the REST config supplier request attempts.
+	ConfigRestRetryMaxDelay = EnvInt(ConfigEnvID+"_REST_RETRY_MAX_DELAY", 30000)
+
+	// ConfigDefaultCommandFormat defines the command config supplier
+	// output format if the format is not present in the config.
+	ConfigDefaultCommandFormat = EnvString(ConfigEnvID+"_DEFAULT_COMMAND_FORMAT", "json")
+
+	// ConfigDefaultCommandTimeout defines the command config supplier
+	// execution timeout, in milliseconds, if the timeout is not present
+	// in the config (zero means no timeout).
+	ConfigDefaultCommandTimeout = EnvInt(ConfigEnvID+"_DEFAULT_COMMAND_TIMEOUT", 10000)
+
 	// ConfigPathSeparator defines the element(s) that will be used to split
 	// a config path string into path elements.
 	ConfigPathSeparator = EnvString(ConfigEnvID+"_PATH_SEPARATOR", ".")
@@ -183,13 +316,71 @@ var (
 	// ConfigLoaderSupplierFormat defines the loader config supplier format.
 	ConfigLoaderSupplierFormat = EnvString(ConfigEnvID+"_LOADER_SUPPLIER_FORMAT", "yaml")
 
+	// ConfigLoaderEntries defines a JSON list of supplier definitions used
+	// as the loader entry suppliers, merged in order. Each entry, and its
+	// active profiles overlays, is registered in its own priority band
+	// above the previous entry ones. If empty, the loader will use the
+	// file defined by the loader file supplier path.
+	ConfigLoaderEntries = EnvString(ConfigEnvID+"_LOADER_ENTRIES", "")
+
 	// ConfigLoaderSupplierListPath defines the loader config supplier
 	// content path to be searched.
 	ConfigLoaderSupplierListPath = EnvString(ConfigEnvID+"_LOADER_SUPPLIER_LIST_PATH", "slate.config.suppliers")
 
+	// ConfigProfiles defines the list of active configuration profiles.
+	// For each profile, the loader will also load the profile entry file
+	// and the profile overlay of every file supplier (the file path with
+	// the profile name before the extension), with increasing precedence.
+	// Each overlay is registered with its base supplier priority added by
+	// the profile position (starting at 1), so it always overrides its base.
+	ConfigProfiles = EnvList(EnvID+"_PROFILE", []string{})
+
 	// ConfigObserveFrequency defines the config observable suppliers
 	// frequency time in milliseconds. Zero for no check.
 	ConfigObserveFrequency = EnvInt(ConfigEnvID+"_OBSERVE_FREQUENCY", 0)
+
+	// ConfigReloadOnSignal defines if the config object should reload
+	// all the suppliers when the process receives a hang-up signal.
+	ConfigReloadOnSignal = EnvBool(ConfigEnvID+"_RELOAD_ON_SIGNAL", false)
+
+	// ConfigIncludeKey defines the key of the file suppliers content
+	// directive used to include other files (or file glob patterns)
+	// relative to the including file.
+	ConfigIncludeKey = EnvString(ConfigEnvID+"_INCLUDE_KEY", "$include")
+
+	// ConfigOverrideSupplierID defines the id of the supplier that holds
+	// the configuration values programmatically set at runtime.
+	ConfigOverrideSupplierID = EnvString(ConfigEnvID+"_OVERRIDE_SUPPLIER_ID", "overrides")
+
+	// ConfigOverrideSupplierPriority defines the priority of the supplier
+	// that holds the configuration values programmatically set at runtime.
+	ConfigOverrideSupplierPriority = EnvInt(ConfigEnvID+"_OVERRIDE_SUPPLIER_PRIORITY", math.MaxInt32)
+
+	// ConfigMergeTombstone defines the value that, when defined by a
+	// supplier, removes the key from the merged configuration.
+	ConfigMergeTombstone = EnvString(ConfigEnvID+"_MERGE_TOMBSTONE", "~delete")
+
+	// ConfigMergeDefaultKey defines the list elements identification key
+	// used by the merge by key strategy if the key is not present in
+	// the strategy config.
+	ConfigMergeDefaultKey = EnvString(ConfigEnvID+"_MERGE_DEFAULT_KEY", "id")
+
+	// ConfigCase defines the config-wide keys case policy applied while
+	// parsing, merging, looking up, observing and populating the
+	// configuration.
+	ConfigCase = EnvString(ConfigEnvID+"_CASE", ConfigCaseLower)
+
+	// ConfigExportMask defines the default list of key patterns that
+	// will have their values masked when exporting the configuration.
+	ConfigExportMask = EnvList(ConfigEnvID+"_EXPORT_MASK", []string{"password", "secret", "token"})
+
+	// ConfigExportMaskValue defines the value used to replace the masked
+	// values when exporting the configuration.
+	ConfigExportMaskValue = EnvString(ConfigEnvID+"_EXPORT_MASK_VALUE", "******")
+
+	// ConfigHistorySize defines the number of committed configuration
+	// revisions kept in the config history. Zero for no history.
+	ConfigHistorySize = EnvInt(ConfigEnvID+"_HISTORY_SIZE", 100)
 )
 
 // ----------------------------------------------------------------------------
@@ -201,6 +392,10 @@ var (
 	// partial assigning action.
 	ErrInvalidEmptyConfigPath = fmt.Errorf("invalid empty config path")
 
+	// ErrInvalidConfigPath defines an error that signals a malformed
+	// config path, like an unterminated index or quoted segment.
+	ErrInvalidConfigPath = fmt.Errorf("invalid config path")
+
 	// ErrConfigPathNotFound defines an error that signals that a path
 	// was not found when requesting a value from a partial or config.
 	ErrConfigPathNotFound = fmt.Errorf("config path not found")
@@ -220,6 +415,44 @@ var (
 	// ErrDuplicateConfigSupplier defines a duplicate config supplier
 	// registration attempt.
 	ErrDuplicateConfigSupplier = fmt.Errorf("config supplier already registered")
+
+	// ErrInvalidConfigMergeStrategy defines an error that signals an
+	// unexpected/unknown config list merge strategy.
+	ErrInvalidConfigMergeStrategy = fmt.Errorf("invalid config merge strategy")
+
+	// ErrInvalidConfigRestResponse defines an error that signals an
+	// unexpected REST config supplier service response status.
+	ErrInvalidConfigRestResponse = fmt.Errorf("invalid config rest response")
+
+	// ErrInvalidConfigRestTimestamp defines an error that signals an
+	// unexpected/unknown observable REST config supplier change
+	// detection strategy.
+	ErrInvalidConfigRestTimestamp = fmt.Errorf("invalid config rest timestamp strategy")
+
+	// ErrInvalidConfigInclude defines an error that signals an invalid
+	// config include directive or a cyclic file inclusion.
+	ErrInvalidConfigInclude = fmt.Errorf("invalid config include")
+
+	// ErrConfigSupplierUnavailable defines an error that signals a
+	// config supplier that could not be created and was skipped or
+	// replaced by its cached copy or fallback supplier.
+	ErrConfigSupplierUnavailable = fmt.Errorf("config supplier unavailable")
+
+	// ErrInvalidConfigCase defines an error that signals an
+	// unexpected/unknown config keys case policy.
+	ErrInvalidConfigCase = fmt.Errorf("invalid config case policy")
+
+	// ErrConfigRevisionNotFound defines an error that signals a config
+	// revision that is not present in the config history.
+	ErrConfigRevisionNotFound = fmt.Errorf("config revision not found")
+
+	// ErrInvalidConfigTemplate defines an error that signals a config
+	// file that could not be rendered as a template.
+	ErrInvalidConfigTemplate = fmt.Errorf("invalid config template")
+
+	// ErrConfigCommandFailed defines an error that signals a config
+	// command supplier executable that failed or timed out.
+	ErrConfigCommandFailed = fmt.Errorf("config command failed")
 )
 
 func errInvalidEmptyConfigPath(
@@ -229,6 +462,13 @@ func errInvalidEmptyConfigPath(
 	return NewErrorFrom(ErrInvalidEmptyConfigPath, path, ctx...)
 }
 
+func errInvalidConfigPath(
+	path string,
+	ctx ...map[string]interface{},
+) error {
+	return NewErrorFrom(ErrInvalidConfigPath, path, ctx...)
+}
+
 func errConfigPathNotFound(
 	path string,
 	ctx ...map[string]interface{},
@@ -250,6 +490,13 @@ func errInvalidConfigSupplier(
 	return NewErrorFrom(ErrInvalidConfigSupplier, fmt.Sprintf("%v", config), ctx...)
 }
 
+func errConfigSupplierUnavailable(
+	id string,
+	ctx ...map[string]interface{},
+) error {
+	return NewErrorFrom(ErrConfigSupplierUnavailable, id, ctx...)
+}
+
 func errConfigSupplierNotFound(
 	id string,
 	ctx ...map[string]interface{},
@@ -264,6 +511,658 @@ func errDuplicateConfigSupplier(
 	return NewErrorFrom(ErrDuplicateConfigSupplier, id, ctx...)
 }
 
+func errInvalidConfigMergeStrategy(
+	strategy string,
+	ctx ...map[string]interface{},
+) error {
+	return NewErrorFrom(ErrInvalidConfigMergeStrategy, strategy, ctx...)
+}
+
+func errInvalidConfigRestTimestamp(
+	strategy string,
+	ctx ...map[string]interface{},
+) error {
+	return NewErrorFrom(ErrInvalidConfigRestTimestamp, strategy, ctx...)
+}
+
+func errInvalidConfigRestResponse(
+	status int,
+	ctx ...map[string]interface{},
+) error {
+	return NewErrorFrom(ErrInvalidConfigRestResponse, fmt.Sprintf("%d", status), ctx...)
+}
+
+func errInvalidConfigCase(
+	policy string,
+	ctx ...map[string]interface{},
+) error {
+	return NewErrorFrom(ErrInvalidConfigCase, policy, ctx...)
+}
+
+func errConfigRevisionNotFound(
+	revision uint64,
+	ctx ...map[string]interface{},
+) error {
+	return NewErrorFrom(ErrConfigRevisionNotFound, fmt.Sprintf("%d", revision), ctx...)
+}
+
+func errInvalidConfigInclude(
+	path string,
+	ctx ...map[string]interface{},
+) error {
+	return NewErrorFrom(ErrInvalidConfigInclude, path, ctx...)
+}
+
+func errInvalidConfigTemplate(
+	path string,
+	ctx ...map[string]interface{},
+) error {
+	return NewErrorFrom(ErrInvalidConfigTemplate, path, ctx...)
+}
+
+func errConfigCommandFailed(
+	command,
+	stderr string,
+	ctx ...map[string]interface{},
+) error {
+	msg := command
+	if stderr = strings.TrimSpace(stderr); stderr != "" {
+		msg = fmt.Sprintf("%s (%s)", command, stderr)
+	}
+	return NewErrorFrom(ErrConfigCommandFailed, msg, ctx...)
+}
+
+// ----------------------------------------------------------------------------
+// config path
+// ----------------------------------------------------------------------------
+
+func configKey(
+	key string,
+) string {
+	return configCaseKey(key, ConfigCase)
+}
+
+func configCaseKey(
+	key,
+	policy string,
+) string {
+	// lowercase the key if requested by the case policy, falling back
+	// to the config-wide policy if none was given
+	if policy == "" {
+		policy = ConfigCase
+	}
+	if policy == ConfigCaseLower {
+		return strings.ToLower(key)
+	}
+	return key
+}
+
+func configKeyEqual(
+	a,
+	b string,
+) bool {
+	// compare the keys case-insensitively if requested by the case policy
+	if ConfigCase == ConfigCaseInsensitive {
+		return strings.EqualFold(a, b)
+	}
+	return a == b
+}
+
+func configPartialKey(
+	partial ConfigPartial,
+	key interface{},
+) (interface{}, bool) {
+	return configCasePartialKey(partial, key, ConfigCase)
+}
+
+func configCasePartialKey(
+	partial ConfigPartial,
+	key interface{},
+	policy string,
+) (interface{}, bool) {
+	// check for the exact key
+	if _, ok := partial[key]; ok {
+		return key, true
+	}
+	// search for a key differing only in case if the case policy
+	// requests case-insensitive lookups
+	stringKey, ok := key.(string)
+	if !ok || policy != ConfigCaseInsensitive {
+		return key, false
+	}
+	var matches []string
+	for k := range partial {
+		if typedKey, ok := k.(string); ok && strings.EqualFold(typedKey, stringKey) {
+			matches = append(matches, typedKey)
+		}
+	}
+	if len(matches) == 0 {
+		return key, false
+	}
+	sort.Strings(matches)
+	return matches[0], true
+}
+
+type configPathSegment struct {
+	key     string
+	index   int
+	indexed bool
+}
+
+func configPathSegments(
+	path string,
+) ([]configPathSegment, error) {
+	// tokenize the path into keys separated by the path separator,
+	// list indexes ([2]) and quoted keys (["db.internal"])
+	var segments []configPathSegment
+	var current strings.Builder
+	flush := func() {
+		// store the current key ignoring empty keys
+		// (double occurrence of a separator)
+		if current.Len() != 0 {
+			segments = append(segments, configPathSegment{key: current.String()})
+			current.Reset()
+		}
+	}
+	for i := 0; i < len(path); {
+		switch {
+		case ConfigPathSeparator != "" && strings.HasPrefix(path[i:], ConfigPathSeparator):
+			flush()
+			i += len(ConfigPathSeparator)
+		case path[i] == '[':
+			flush()
+			segment, size, e := configPathBracket(path, i)
+			if e != nil {
+				return nil, e
+			}
+			segments = append(segments, segment)
+			i += size
+		default:
+			current.WriteByte(path[i])
+			i++
+		}
+	}
+	flush()
+	return segments, nil
+}
+
+func configPathBracket(
+	path string,
+	start int,
+) (configPathSegment, int, error) {
+	// check if the bracket holds a quoted key
+	i := start + 1
+	if i < len(path) && (path[i] == '"' || path[i] == '\'') {
+		quote := path[i]
+		var key strings.Builder
+		for i++; i < len(path); i++ {
+			switch {
+			case path[i] == '\\' && i+1 < len(path):
+				i++
+				key.WriteByte(path[i])
+			case path[i] == quote:
+				// the quoted key must be followed by the bracket closing
+				if i+1 >= len(path) || path[i+1] != ']' {
+					return configPathSegment{}, 0, errInvalidConfigPath(path)
+				}
+				return configPathSegment{key: key.String()}, i + 2 - start, nil
+			default:
+				key.WriteByte(path[i])
+			}
+		}
+		return configPathSegment{}, 0, errInvalidConfigPath(path)
+	}
+	// retrieve the bracket content as an index, or a key if not numeric
+	end := strings.IndexByte(path[i:], ']')
+	if end < 0 {
+		return configPathSegment{}, 0, errInvalidConfigPath(path)
+	}
+	content := strings.TrimSpace(path[i : i+end])
+	if index, e := strconv.Atoi(content); e == nil && index >= 0 {
+		return configPathSegment{index: index, indexed: true}, end + 2, nil
+	}
+	return configPathSegment{key: content}, end + 2, nil
+}
+
+func configPathKey(
+	prefix string,
+	key interface{},
+) string {
+	// quote the key if it can't be used as a plain path segment
+	k := fmt.Sprintf("%v", key)
+	if k == "" || strings.ContainsAny(k, "[]\"'") || (ConfigPathSeparator != "" && strings.Contains(k, ConfigPathSeparator)) {
+		return prefix + `["` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(k) + `"]`
+	}
+	if prefix == "" {
+		return k
+	}
+	return prefix + ConfigPathSeparator + k
+}
+
+func configPathString(
+	segments []configPathSegment,
+) string {
+	// compose the canonical path of the segments
+	path := ""
+	for _, segment := range segments {
+		if segment.indexed {
+			path += fmt.Sprintf("[%d]", segment.index)
+		} else {
+			path = configPathKey(path, segment.key)
+		}
+	}
+	return path
+}
+
+func configPathList(
+	value interface{},
+	segment configPathSegment,
+) ([]interface{}, int, bool) {
+	// check if the value is a list and the segment is an index, or a
+	// numeric key, of the list
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, 0, false
+	}
+	index := segment.index
+	if !segment.indexed {
+		var e error
+		if index, e = strconv.Atoi(segment.key); e != nil || index < 0 {
+			return nil, 0, false
+		}
+	}
+	return list, index, true
+}
+
+// ----------------------------------------------------------------------------
+// config value
+// ----------------------------------------------------------------------------
+
+var configByteSizeUnits = map[string]float64{
+	"":    1,
+	"b":   1,
+	"k":   1000,
+	"kb":  1000,
+	"m":   1000 * 1000,
+	"mb":  1000 * 1000,
+	"g":   1000 * 1000 * 1000,
+	"gb":  1000 * 1000 * 1000,
+	"t":   1000 * 1000 * 1000 * 1000,
+	"tb":  1000 * 1000 * 1000 * 1000,
+	"ki":  1 << 10,
+	"kib": 1 << 10,
+	"mi":  1 << 20,
+	"mib": 1 << 20,
+	"gi":  1 << 30,
+	"gib": 1 << 30,
+	"ti":  1 << 40,
+	"tib": 1 << 40,
+}
+
+var configByteSizeRegexp = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)\s*([a-zA-Z]*)$`)
+
+// ConfigValue will retrieve the value stored in the requested path of
+// the given supplier (config or partial), leniently converting it to the
+// requested type. Struct types are populated from the stored partial.
+func ConfigValue[T any](
+	supplier ConfigSupplier,
+	path string,
+	def ...T,
+) (T, error) {
+	var result T
+	// check supplier argument reference
+	if supplier == nil {
+		return result, errNilPointer("supplier")
+	}
+	// retrieve the supplier value
+	val, e := supplier.Get(path)
+	if e != nil {
+		if len(def) > 0 {
+			return def[0], nil
+		}
+		return result, e
+	}
+	// convert the retrieved value to the requested type
+	converted, e := configValue(val, reflect.TypeOf(&result).Elem())
+	if e != nil {
+		return result, e
+	}
+	return converted.(T), nil
+}
+
+func configValue(
+	value interface{},
+	target reflect.Type,
+) (interface{}, error) {
+	// convert to the well known types
+	switch target {
+	case reflect.TypeOf(time.Duration(0)):
+		return configValueDuration(value)
+	case reflect.TypeOf(time.Time{}):
+		return configValueTime(value)
+	case reflect.TypeOf([]string{}):
+		return configValueStringList(value)
+	case reflect.TypeOf(map[string]string{}):
+		return configValueStringMap(value)
+	}
+	// check if the value can be directly assigned
+	if value != nil && reflect.TypeOf(value).AssignableTo(target) {
+		result := reflect.New(target).Elem()
+		result.Set(reflect.ValueOf(value))
+		return result.Interface(), nil
+	}
+	// convert by the target kind
+	result := reflect.New(target).Elem()
+	switch target.Kind() {
+	case reflect.Bool:
+		typedValue, e := configValueBool(value)
+		if e != nil {
+			return nil, e
+		}
+		result.SetBool(typedValue)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		typedValue, e := configValueInt64(value)
+		if e != nil {
+			return nil, e
+		}
+		if result.OverflowInt(typedValue) {
+			return nil, errConversion(value, target.String())
+		}
+		result.SetInt(typedValue)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		typedValue, e := configValueUint64(value)
+		if e != nil {
+			return nil, e
+		}
+		if result.OverflowUint(typedValue) {
+			return nil, errConversion(value, target.String())
+		}
+		result.SetUint(typedValue)
+	case reflect.Float32, reflect.Float64:
+		typedValue, e := configValueFloat64(value)
+		if e != nil {
+			return nil, e
+		}
+		result.SetFloat(typedValue)
+	case reflect.String:
+		typedValue, e := configValueString(value)
+		if e != nil {
+			return nil, e
+		}
+		result.SetString(typedValue)
+	case reflect.Struct:
+		partial, ok := value.(ConfigPartial)
+		if !ok {
+			return nil, errConversion(value, target.String())
+		}
+		if _, e := partial.populate(partial, result, true); e != nil {
+			return nil, e
+		}
+	default:
+		return nil, errConversion(value, target.String())
+	}
+	return result.Interface(), nil
+}
+
+func configValueBool(
+	value interface{},
+) (bool, error) {
+	// convert the boolean value shapes produced by the suppliers
+	switch typedValue := value.(type) {
+	case bool:
+		return typedValue, nil
+	case string:
+		result, e := strconv.ParseBool(strings.TrimSpace(typedValue))
+		if e != nil {
+			return false, errConversion(value, "bool")
+		}
+		return result, nil
+	}
+	return false, errConversion(value, "bool")
+}
+
+func configValueInt64(
+	value interface{},
+) (int64, error) {
+	// convert the numeric value shapes produced by the suppliers
+	switch typedValue := value.(type) {
+	case int:
+		return int64(typedValue), nil
+	case int8:
+		return int64(typedValue), nil
+	case int16:
+		return int64(typedValue), nil
+	case int32:
+		return int64(typedValue), nil
+	case int64:
+		return typedValue, nil
+	case uint:
+		return configValueInt64(uint64(typedValue))
+	case uint8:
+		return int64(typedValue), nil
+	case uint16:
+		return int64(typedValue), nil
+	case uint32:
+		return int64(typedValue), nil
+	case uint64:
+		if typedValue > math.MaxInt64 {
+			return 0, errConversion(value, "int64")
+		}
+		return int64(typedValue), nil
+	case float32:
+		return configValueInt64(float64(typedValue))
+	case float64:
+		if typedValue != math.Trunc(typedValue) ||
+			typedValue < math.MinInt64 ||
+			typedValue >= math.MaxInt64 {
+			return 0, errConversion(value, "int64")
+		}
+		return int64(typedValue), nil
+	case string:
+		result, e := strconv.ParseInt(strings.TrimSpace(typedValue), 10, 64)
+		if e != nil {
+			return 0, errConversion(value, "int64")
+		}
+		return result, nil
+	}
+	return 0, errConversion(value, "int64")
+}
+
+func configValueUint64(
+	value interface{},
+) (uint64, error) {
+	// convert the unsigned numeric value shapes produced by the suppliers
+	switch typedValue := value.(type) {
+	case uint:
+		return uint64(typedValue), nil
+	case uint64:
+		return typedValue, nil
+	case string:
+		result, e := strconv.ParseUint(strings.TrimSpace(typedValue), 10, 64)
+		if e != nil {
+			return 0, errConversion(value, "uint64")
+		}
+		return result, nil
+	}
+	// convert any other numeric value discarding negative values
+	result, e := configValueInt64(value)
+	if e != nil || result < 0 {
+		return 0, errConversion(value, "uint64")
+	}
+	return uint64(result), nil
+}
+
+func configValueFloat64(
+	value interface{},
+) (float64, error) {
+	// convert the floating point value shapes produced by the suppliers
+	switch typedValue := value.(type) {
+	case float64:
+		return typedValue, nil
+	case float32:
+		return float64(typedValue), nil
+	case string:
+		result, e := strconv.ParseFloat(strings.TrimSpace(typedValue), 64)
+		if e != nil {
+			return 0, errConversion(value, "float64")
+		}
+		return result, nil
+	}
+	// convert any other numeric value
+	result, e := configValueInt64(value)
+	if e != nil {
+		return 0, errConversion(value, "float64")
+	}
+	return float64(result), nil
+}
+
+func configValueString(
+	value interface{},
+) (string, error) {
+	// convert the scalar value shapes produced by the suppliers
+	switch typedValue := value.(type) {
+	case string:
+		return typedValue, nil
+	case bool:
+		return strconv.FormatBool(typedValue), nil
+	case float32:
+		return strconv.FormatFloat(float64(typedValue), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(typedValue, 'f', -1, 64), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", typedValue), nil
+	}
+	return "", errConversion(value, "string")
+}
+
+func configValueDuration(
+	value interface{},
+) (time.Duration, error) {
+	// convert the duration value shapes produced by the suppliers,
+	// where plain numbers are interpreted as milliseconds
+	switch typedValue := value.(type) {
+	case time.Duration:
+		return typedValue, nil
+	case string:
+		typedValue = strings.TrimSpace(typedValue)
+		if millis, e := strconv.ParseInt(typedValue, 10, 64); e == nil {
+			return time.Duration(millis) * time.Millisecond, nil
+		}
+		result, e := time.ParseDuration(typedValue)
+		if e != nil {
+			return 0, errConversion(value, "time.Duration")
+		}
+		return result, nil
+	}
+	millis, e := configValueFloat64(value)
+	if e != nil {
+		return 0, errConversion(value, "time.Duration")
+	}
+	return time.Duration(millis * float64(time.Millisecond)), nil
+}
+
+func configValueTime(
+	value interface{},
+) (time.Time, error) {
+	// convert the time value shapes produced by the suppliers,
+	// where plain numbers are interpreted as unix epoch seconds
+	switch typedValue := value.(type) {
+	case time.Time:
+		return typedValue, nil
+	case string:
+		typedValue = strings.TrimSpace(typedValue)
+		for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02"} {
+			if result, e := time.Parse(layout, typedValue); e == nil {
+				return result, nil
+			}
+		}
+		if seconds, e := strconv.ParseInt(typedValue, 10, 64); e == nil {
+			return time.Unix(seconds, 0), nil
+		}
+		return time.Time{}, errConversion(value, "time.Time")
+	}
+	seconds, e := configValueInt64(value)
+	if e != nil {
+		return time.Time{}, errConversion(value, "time.Time")
+	}
+	return time.Unix(seconds, 0), nil
+}
+
+func configValueByteSize(
+	value interface{},
+) (int64, error) {
+	// convert the plain numeric values as a number of bytes
+	typedValue, ok := value.(string)
+	if !ok {
+		return configValueInt64(value)
+	}
+	// parse the size and unit parts of the value
+	match := configByteSizeRegexp.FindStringSubmatch(strings.TrimSpace(typedValue))
+	if match == nil {
+		return 0, errConversion(value, "byte size")
+	}
+	unit, ok := configByteSizeUnits[strings.ToLower(match[2])]
+	if !ok {
+		return 0, errConversion(value, "byte size")
+	}
+	size, _ := strconv.ParseFloat(match[1], 64)
+	if size*unit >= math.MaxInt64 {
+		return 0, errConversion(value, "byte size")
+	}
+	return int64(size * unit), nil
+}
+
+func configValueStringList(
+	value interface{},
+) ([]string, error) {
+	// convert the list value shapes produced by the suppliers, where
+	// strings are interpreted as comma separated lists
+	switch typedValue := value.(type) {
+	case []string:
+		return typedValue, nil
+	case string:
+		result := []string{}
+		for _, item := range strings.Split(typedValue, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				result = append(result, item)
+			}
+		}
+		return result, nil
+	case []interface{}:
+		result := make([]string, 0, len(typedValue))
+		for _, item := range typedValue {
+			converted, e := configValueString(item)
+			if e != nil {
+				return nil, errConversion(value, "[]string")
+			}
+			result = append(result, converted)
+		}
+		return result, nil
+	}
+	return nil, errConversion(value, "[]string")
+}
+
+func configValueStringMap(
+	value interface{},
+) (map[string]string, error) {
+	// convert the partial value shapes produced by the suppliers
+	switch typedValue := value.(type) {
+	case map[string]string:
+		return typedValue, nil
+	case ConfigPartial:
+		result := map[string]string{}
+		for k, v := range typedValue {
+			converted, e := configValueString(v)
+			if e != nil {
+				return nil, errConversion(value, "map[string]string")
+			}
+			result[fmt.Sprintf("%v", k)] = converted
+		}
+		return result, nil
+	}
+	return nil, errConversion(value, "map[string]string")
+}
+
 // ----------------------------------------------------------------------------
 // config partial
 // ----------------------------------------------------------------------------
@@ -279,7 +1178,7 @@ func (p *ConfigPartial) Clone() ConfigPartial {
 		switch typedValue := value.(type) {
 		// recursive list scenario
 		case []interface{}:
-			var result []interface{}
+			result := make([]interface{}, 0, len(typedValue))
 			for _, i := range typedValue {
 				result = append(result, cloner(i))
 			}
@@ -323,57 +1222,141 @@ func (p *ConfigPartial) Has(
 }
 
 // Set will store a value in the requested partial path.
+// The path can reference list elements by index (items[2].name) and keys
+// containing the path separator by quoting them (hosts["db.internal"]).
+// Setting the index next to the last element of a list appends the value.
 func (p *ConfigPartial) Set(
 	path string,
 	value interface{},
 ) (*ConfigPartial, error) {
-	// retrieve the path parts
+	// retrieve the path segments
 	if path == "" {
 		return nil, errInvalidEmptyConfigPath(path)
 	}
-	parts := strings.Split(path, ConfigPathSeparator)
-
-	// iterate through the path
-	it := p
-	if len(parts) == 1 {
-		(*it)[path] = value
-		return p, nil
-	}
-
-	// path part partial generation
-	generate := func(part string) {
-		// check if the part exists and is a partial struct
-		generate := false
-		if next, ok := (*it)[part]; !ok {
-			generate = true
-		} else if _, ok := next.(ConfigPartial); !ok {
-			generate = true
-		}
-		// generate the part if not present
-		if generate == true {
-			(*it)[part] = ConfigPartial{}
-		}
+	segments, e := configPathSegments(path)
+	if e != nil {
+		return nil, e
+	}
+	if len(segments) == 0 {
+		return nil, errInvalidEmptyConfigPath(path)
+	}
+	// store the value in the partial tree
+	if _, e := p.set(path, *p, segments, value); e != nil {
+		return nil, e
 	}
+	return p, nil
+}
 
-	// part walkthrough
-	for _, part := range parts[:len(parts)-1] {
-		// if the iterated path is empty
-		// (double occurrence of a separator), just continue
-		if part == "" {
-			continue
+func (p *ConfigPartial) set(
+	path string,
+	node interface{},
+	segments []configPathSegment,
+	value interface{},
+) (interface{}, error) {
+	// check if the end of the path has been reached
+	if len(segments) == 0 {
+		return value, nil
+	}
+	segment := segments[0]
+	// check if the segment references a list element
+	if list, index, ok := configPathList(node, segment); ok {
+		switch {
+		case index < len(list):
+			next, e := p.set(path, list[index], segments[1:], value)
+			if e != nil {
+				return nil, e
+			}
+			list[index] = next
+			return list, nil
+		case index == len(list):
+			next, e := p.set(path, nil, segments[1:], value)
+			if e != nil {
+				return nil, e
+			}
+			return append(list, next), nil
+		default:
+			return nil, errConfigPathNotFound(path)
 		}
-		// generate the part and advance the iteration
-		generate(part)
-		next := (*it)[part].(ConfigPartial)
-		it = &next
 	}
+	// an index segment can only reference a list element
+	if segment.indexed {
+		return nil, errConfigPathNotFound(path)
+	}
+	// generate the partial if the node is not a partial
+	partial, ok := node.(ConfigPartial)
+	if !ok {
+		partial = ConfigPartial{}
+	}
+	key, _ := configPartialKey(partial, segment.key)
+	next, e := p.set(path, partial[key], segments[1:], value)
+	if e != nil {
+		return nil, e
+	}
+	partial[key] = next
+	return partial, nil
+}
 
-	// store the desired value
-	part := parts[len(parts)-1:][0]
-	generate(part)
-	(*it)[part] = value
+func (p *ConfigPartial) unset(
+	path string,
+) error {
+	// retrieve the path segments
+	segments, e := configPathSegments(path)
+	if e != nil {
+		return e
+	}
+	if len(segments) == 0 {
+		return errInvalidEmptyConfigPath(path)
+	}
+	// remove the value from the partial tree
+	_, e = p.remove(path, *p, segments)
+	return e
+}
 
-	return p, nil
+func (p *ConfigPartial) remove(
+	path string,
+	node interface{},
+	segments []configPathSegment,
+) (interface{}, error) {
+	segment := segments[0]
+	// check if the segment references a list element
+	if list, index, ok := configPathList(node, segment); ok {
+		if index >= len(list) {
+			return nil, errConfigPathNotFound(path)
+		}
+		if len(segments) == 1 {
+			return append(append([]interface{}{}, list[:index]...), list[index+1:]...), nil
+		}
+		next, e := p.remove(path, list[index], segments[1:])
+		if e != nil {
+			return nil, e
+		}
+		list[index] = next
+		return list, nil
+	}
+	// check if the segment references a present partial key
+	partial, ok := node.(ConfigPartial)
+	if !ok || segment.indexed {
+		return nil, errConfigPathNotFound(path)
+	}
+	key, ok := configPartialKey(partial, segment.key)
+	if !ok {
+		return nil, errConfigPathNotFound(path)
+	}
+	if len(segments) == 1 {
+		delete(partial, key)
+		return partial, nil
+	}
+	next, e := p.remove(path, partial[key], segments[1:])
+	if e != nil {
+		return nil, e
+	}
+	// discard the partials left empty by the removal
+	if typedNext, ok := next.(ConfigPartial); ok && len(typedNext) == 0 {
+		delete(partial, key)
+	} else {
+		partial[key] = next
+	}
+	return partial, nil
 }
 
 // Get will retrieve the value stored in the requested path.
@@ -558,6 +1541,175 @@ func (p *ConfigPartial) Partial(
 	return nil, errConversion(val, "ConfigPartial")
 }
 
+// Duration will retrieve a value stored in the quested path
+// leniently converting it to a duration, accepting
+// duration strings ("1m30s") or a number of milliseconds
+func (p *ConfigPartial) Duration(
+	path string,
+	def ...time.Duration,
+) (time.Duration, error) {
+	var val interface{}
+	var e error
+
+	// retrieve the partial value
+	if len(def) > 0 {
+		val, e = p.Get(path, def[0])
+	} else {
+		val, e = p.Get(path)
+	}
+	// error retrieving the path value
+	if e != nil {
+		return 0, e
+	}
+	// result conversion
+	return configValueDuration(val)
+}
+
+// Time will retrieve a value stored in the quested path
+// leniently converting it to a time, accepting
+// RFC3339 strings or a number of unix epoch seconds
+func (p *ConfigPartial) Time(
+	path string,
+	def ...time.Time,
+) (time.Time, error) {
+	var val interface{}
+	var e error
+
+	// retrieve the partial value
+	if len(def) > 0 {
+		val, e = p.Get(path, def[0])
+	} else {
+		val, e = p.Get(path)
+	}
+	// error retrieving the path value
+	if e != nil {
+		return time.Time{}, e
+	}
+	// result conversion
+	return configValueTime(val)
+}
+
+// Uint will retrieve a value stored in the quested path
+// leniently converting it to an unsigned integer
+func (p *ConfigPartial) Uint(
+	path string,
+	def ...uint,
+) (uint, error) {
+	var val interface{}
+	var e error
+
+	// retrieve the partial value
+	if len(def) > 0 {
+		val, e = p.Get(path, def[0])
+	} else {
+		val, e = p.Get(path)
+	}
+	// error retrieving the path value
+	if e != nil {
+		return 0, e
+	}
+	// result conversion
+	typedValue, e := configValueUint64(val)
+	if e != nil || uint64(uint(typedValue)) != typedValue {
+		return 0, errConversion(val, "uint")
+	}
+	return uint(typedValue), nil
+}
+
+// Int64 will retrieve a value stored in the quested path
+// leniently converting it to a 64 bit integer
+func (p *ConfigPartial) Int64(
+	path string,
+	def ...int64,
+) (int64, error) {
+	var val interface{}
+	var e error
+
+	// retrieve the partial value
+	if len(def) > 0 {
+		val, e = p.Get(path, def[0])
+	} else {
+		val, e = p.Get(path)
+	}
+	// error retrieving the path value
+	if e != nil {
+		return 0, e
+	}
+	// result conversion
+	return configValueInt64(val)
+}
+
+// ByteSize will retrieve a value stored in the quested path
+// leniently converting it to a number of
+// bytes, accepting sizes with units like "10MB" or "512KiB"
+func (p *ConfigPartial) ByteSize(
+	path string,
+	def ...int64,
+) (int64, error) {
+	var val interface{}
+	var e error
+
+	// retrieve the partial value
+	if len(def) > 0 {
+		val, e = p.Get(path, def[0])
+	} else {
+		val, e = p.Get(path)
+	}
+	// error retrieving the path value
+	if e != nil {
+		return 0, e
+	}
+	// result conversion
+	return configValueByteSize(val)
+}
+
+// StringList will retrieve a value stored in the quested path
+// leniently converting it to a list of strings,
+// accepting comma separated strings
+func (p *ConfigPartial) StringList(
+	path string,
+	def ...[]string,
+) ([]string, error) {
+	var val interface{}
+	var e error
+
+	// retrieve the partial value
+	if len(def) > 0 {
+		val, e = p.Get(path, def[0])
+	} else {
+		val, e = p.Get(path)
+	}
+	// error retrieving the path value
+	if e != nil {
+		return nil, e
+	}
+	// result conversion
+	return configValueStringList(val)
+}
+
+// StringMap will retrieve a value stored in the quested path
+// leniently converting it to a map of strings
+func (p *ConfigPartial) StringMap(
+	path string,
+	def ...map[string]string,
+) (map[string]string, error) {
+	var val interface{}
+	var e error
+
+	// retrieve the partial value
+	if len(def) > 0 {
+		val, e = p.Get(path, def[0])
+	} else {
+		val, e = p.Get(path)
+	}
+	// error retrieving the path value
+	if e != nil {
+		return nil, e
+	}
+	// result conversion
+	return configValueStringMap(val)
+}
+
 // Populate will try to populate the data argument with the data stored
 // in the path partial location.
 func (p *ConfigPartial) Populate(
@@ -569,7 +1721,7 @@ func (p *ConfigPartial) Populate(
 	isInsensitive := false
 	if len(insensitive) == 0 || insensitive[0] == true {
 		isInsensitive = true
-		path = strings.ToLower(path)
+		path = configKey(path)
 	}
 	// retrieve the partial value
 	value, e := p.Get(path)
@@ -586,26 +1738,55 @@ func (p *ConfigPartial) Populate(
 }
 
 // Merge will increment the current partial instance with the
-// information stored in another partial.
+// information stored in another partial. The optional strategies define
+// how the lists stored in specific paths are merged (lists are replaced
+// by default), and any supplier value equal to the tombstone marker
+// will remove the key from the current partial.
 func (p *ConfigPartial) Merge(
 	src ConfigPartial,
+	strategies ...ConfigMergeStrategy,
+) {
+	p.merge("", src, strategies)
+}
+
+func (p *ConfigPartial) merge(
+	path string,
+	src ConfigPartial,
+	strategies []ConfigMergeStrategy,
 ) {
 	// try to Merge every supplier stored element into the target partial
 	for key, value := range src {
-		// if the key does not exist in the target partial, just store it
-		if local, ok := (*p)[key]; !ok {
-			(*p)[key] = value
-		} else {
-			// check if the 2 are partials
-			typedLocal, okLocal := local.(ConfigPartial)
-			typedValue, okValue := value.(ConfigPartial)
+		// use the current key if it differs only in case and the case
+		// policy requests case-insensitive lookups
+		key, _ = configPartialKey(*p, key)
+		keyPath := configPathKey(path, key)
+		// check if the value is a tombstone that removes the key
+		if value == ConfigMergeTombstone {
+			delete(*p, key)
+			continue
+		}
+		local, ok := (*p)[key]
+		// check if the 2 are partials
+		typedLocal, okLocal := local.(ConfigPartial)
+		typedValue, okValue := value.(ConfigPartial)
+		switch {
+		case okValue && (!ok || !okLocal):
+			// store the partial discarding any tombstone
+			typedLocal = ConfigPartial{}
+			typedLocal.merge(keyPath, typedValue, strategies)
+			(*p)[key] = typedLocal
+		case okValue:
+			// Merge the both partials
+			typedLocal.merge(keyPath, typedValue, strategies)
+		default:
+			// check if the 2 are lists to be merged by a strategy
+			listLocal, okLocal := local.([]interface{})
+			listValue, okValue := value.([]interface{})
 			if okLocal && okValue {
-				// Merge the both partials
-				typedLocal.Merge(typedValue)
-			} else {
-				// just override the target value
-				(*p)[key] = value
+				value = configMergeList(keyPath, listLocal, listValue, strategies)
 			}
+			// just override the target value
+			(*p)[key] = value
 		}
 	}
 }
@@ -616,19 +1797,31 @@ func (p *ConfigPartial) path(
 	var ok bool
 	var it interface{}
 
+	// retrieve the path segments
+	segments, e := configPathSegments(path)
+	if e != nil {
+		return nil, e
+	}
 	// iterate through the path
 	it = *p
-	for _, part := range strings.Split(path, ConfigPathSeparator) {
-		// if the iterated path is empty
-		// (double occurrence of a separator), just continue
-		if part == "" {
+	for _, segment := range segments {
+		// check if the iterated segment references a list element
+		if list, index, ok := configPathList(it, segment); ok {
+			if index >= len(list) {
+				return nil, errConfigPathNotFound(path)
+			}
+			it = list[index]
 			continue
 		}
 		switch typedIt := it.(type) {
-		// check if the iterated part references a partial
+		// check if the iterated segment references a partial
 		case ConfigPartial:
-			// retrieve the part reference
-			if it, ok = typedIt[part]; !ok {
+			// retrieve the segment reference
+			if segment.indexed {
+				return nil, errConfigPathNotFound(path)
+			}
+			key, _ := configPartialKey(typedIt, segment.key)
+			if it, ok = typedIt[key]; !ok {
 				return nil, errConfigPathNotFound(path)
 			}
 		default:
@@ -668,11 +1861,8 @@ func (p *ConfigPartial) populate(
 				switch fieldValue.Kind() {
 				case reflect.Struct:
 					// get the configuration value
-					path := fieldType.Name
-					if insensitive {
-						path = strings.ToLower(path)
-					}
 					config := source.(ConfigPartial)
+					path := configFieldKey(config, fieldType.Name, insensitive)
 					data, e := config.Partial(path)
 					if e != nil {
 						continue
@@ -683,17 +1873,14 @@ func (p *ConfigPartial) populate(
 					}
 				default:
 					// get the configuration value
-					path := fieldType.Name
-					if insensitive {
-						path = strings.ToLower(path)
-					}
 					config := source.(ConfigPartial)
+					path := configFieldKey(config, fieldType.Name, insensitive)
 					data, e := config.Get(path)
 					if e != nil {
 						continue
 					}
 					// assign the configuration value to the field
-					if reflect.TypeOf(data) != fieldType.Type {
+					if dataType := reflect.TypeOf(data); dataType == nil || !dataType.AssignableTo(fieldType.Type) {
 						return nil, errConversion(data, fieldType.Type.Name())
 					}
 					fieldValue.Set(reflect.ValueOf(data))
@@ -706,6 +1893,134 @@ func (p *ConfigPartial) populate(
 	return target.Interface(), nil
 }
 
+func configFieldKey(
+	partial ConfigPartial,
+	name string,
+	insensitive bool,
+) string {
+	// use the field name if the population is case-sensitive
+	if !insensitive {
+		return name
+	}
+	// search for the field name, the lowercase field name or a key
+	// differing only in case, in this order
+	lower := strings.ToLower(name)
+	var matches []string
+	for k := range partial {
+		switch typedKey, _ := k.(string); {
+		case typedKey == name:
+			return name
+		case typedKey == lower:
+			matches = append([]string{lower}, matches...)
+		case strings.EqualFold(typedKey, name):
+			matches = append(matches, typedKey)
+		}
+	}
+	if len(matches) == 0 {
+		return lower
+	}
+	if matches[0] != lower {
+		sort.Strings(matches)
+	}
+	return matches[0]
+}
+
+// ConfigMergeStrategy defines the strategy used to merge the lists stored
+// in a path (that can contain single star wildcard parts) when merging
+// two partials.
+type ConfigMergeStrategy struct {
+	Path     string
+	Strategy string
+	Key      string
+}
+
+// NewConfigMergeStrategies will parse a list of merge strategy
+// definitions, as found in a supplier definition, into a list of
+// merge strategies.
+func NewConfigMergeStrategies(
+	list []interface{},
+) ([]ConfigMergeStrategy, error) {
+	var strategies []ConfigMergeStrategy
+	for _, entry := range list {
+		// retrieve the strategy definition
+		partial, ok := entry.(ConfigPartial)
+		if !ok {
+			return nil, errConversion(entry, "ConfigPartial")
+		}
+		strategy := ConfigMergeStrategy{Key: ConfigMergeDefaultKey}
+		if _, e := partial.Populate("", &strategy); e != nil {
+			return nil, e
+		}
+		// validate the strategy definition
+		switch strategy.Strategy {
+		case ConfigMergeReplace, ConfigMergeAppend, ConfigMergePrepend, ConfigMergeByKey:
+		default:
+			return nil, errInvalidConfigMergeStrategy(strategy.Strategy)
+		}
+		strategies = append(strategies, strategy)
+	}
+	return strategies, nil
+}
+
+func configMergeList(
+	path string,
+	local,
+	value []interface{},
+	strategies []ConfigMergeStrategy,
+) []interface{} {
+	// search for the strategy of the path
+	for _, strategy := range strategies {
+		if len(configPathParts(strategy.Path)) != len(configPathParts(path)) ||
+			!configPathMatch(strategy.Path, path) {
+			continue
+		}
+		switch strategy.Strategy {
+		case ConfigMergeAppend:
+			return append(append([]interface{}{}, local...), value...)
+		case ConfigMergePrepend:
+			return append(append([]interface{}{}, value...), local...)
+		case ConfigMergeByKey:
+			return configMergeListByKey(local, value, strategy.Key)
+		default:
+			return value
+		}
+	}
+	return value
+}
+
+func configMergeListByKey(
+	local,
+	value []interface{},
+	key string,
+) []interface{} {
+	result := append([]interface{}{}, local...)
+	for _, item := range value {
+		// search for a local element with the same key value
+		merged := false
+		if partial, ok := item.(ConfigPartial); ok {
+			if id, ok := partial[key]; ok {
+				for i, localItem := range result {
+					localPartial, ok := localItem.(ConfigPartial)
+					if !ok || !reflect.DeepEqual(localPartial[key], id) {
+						continue
+					}
+					// merge the element into a copy of the local element
+					target := localPartial.Clone()
+					target.Merge(partial)
+					result[i] = target
+					merged = true
+					break
+				}
+			}
+		}
+		// add the elements not found in the local list
+		if !merged {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
 // ConfigConvert will convert the given value to a config partial
 // normalized value. This means that the map based values will be
 // cast as a config partial instance and for any other ones
@@ -713,18 +2028,25 @@ func (p *ConfigPartial) populate(
 // The conversion is recursive, at all levels.
 func ConfigConvert(
 	val interface{},
+) interface{} {
+	return configConvert(val, ConfigCase)
+}
+
+func configConvert(
+	val interface{},
+	policy string,
 ) interface{} {
 	// recursive conversion call
 	if pValue, ok := val.(ConfigPartial); ok {
 		// return the recursive conversion of the partial
 		p := ConfigPartial{}
 		for k, value := range pValue {
-			// turn all string keys into lowercase
+			// normalize all string keys by the case policy
 			stringKey, ok := k.(string)
 			if ok {
-				p[strings.ToLower(stringKey)] = ConfigConvert(value)
+				p[configCaseKey(stringKey, policy)] = configConvert(value, policy)
 			} else {
-				p[k] = ConfigConvert(value)
+				p[k] = configConvert(value, policy)
 			}
 		}
 		return p
@@ -732,7 +2054,7 @@ func ConfigConvert(
 	if lValue, ok := val.([]interface{}); ok {
 		var result []interface{}
 		for _, i := range lValue {
-			result = append(result, ConfigConvert(i))
+			result = append(result, configConvert(i, policy))
 		}
 		return result
 	}
@@ -741,8 +2063,8 @@ func ConfigConvert(
 		// return the recursive conversion of the partial
 		result := ConfigPartial{}
 		for k, i := range mValue {
-			// turn all string keys into lowercase
-			result[strings.ToLower(k)] = ConfigConvert(i)
+			// normalize all string keys by the case policy
+			result[configCaseKey(k, policy)] = configConvert(i, policy)
 		}
 		return result
 	}
@@ -751,12 +2073,12 @@ func ConfigConvert(
 		// return the recursive conversion of the partial
 		result := ConfigPartial{}
 		for k, i := range mValue {
-			// turn all string keys into lowercase
+			// normalize all string keys by the case policy
 			stringKey, ok := k.(string)
 			if ok {
-				result[strings.ToLower(stringKey)] = ConfigConvert(i)
+				result[configCaseKey(stringKey, policy)] = configConvert(i, policy)
 			} else {
-				result[k] = ConfigConvert(i)
+				result[k] = configConvert(i, policy)
 			}
 		}
 		return result
@@ -787,6 +2109,22 @@ type ConfigParser interface {
 	Parse() (*ConfigPartial, error)
 }
 
+type configCaseParser interface {
+	setCase(policy string)
+}
+
+func configParse(
+	parser ConfigParser,
+	policy string,
+) (*ConfigPartial, error) {
+	// parse the content with the supplier case policy, if the parser
+	// supports it, instead of the config-wide one
+	if caseParser, ok := parser.(configCaseParser); ok && policy != "" {
+		caseParser.setCase(policy)
+	}
+	return parser.Parse()
+}
+
 // ----------------------------------------------------------------------------
 // config parser creator
 // ----------------------------------------------------------------------------
@@ -854,6 +2192,7 @@ type ConfigUnderlyingDecoder interface {
 type ConfigDecoder struct {
 	Reader            io.Reader
 	UnderlyingDecoder ConfigUnderlyingDecoder
+	policy            string
 }
 
 var _ ConfigParser = &ConfigDecoder{}
@@ -894,10 +2233,16 @@ func (d *ConfigDecoder) Parse() (*ConfigPartial, error) {
 		return nil, e
 	}
 	// convert the read data into a normalized partial
-	result := ConfigConvert(data).(ConfigPartial)
+	result := configConvert(data, d.policy).(ConfigPartial)
 	return &result, nil
 }
 
+func (d *ConfigDecoder) setCase(
+	policy string,
+) {
+	d.policy = policy
+}
+
 // ----------------------------------------------------------------------------
 // config json decoder
 // ----------------------------------------------------------------------------
@@ -997,7 +2342,7 @@ func (d ConfigYAMLDecoder) Parse() (*ConfigPartial, error) {
 		return nil, e
 	}
 	// convert the read data into a normalized config
-	result := ConfigConvert(data).(ConfigPartial)
+	result := configConvert(data, d.policy).(ConfigPartial)
 	return &result, nil
 }
 
@@ -1060,6 +2405,14 @@ type ConfigObsSupplier interface {
 	Reload() (bool, error)
 }
 
+// ConfigRefreshSupplier interface extends the ConfigSupplier interface
+// with a method used to force the supplier to re-read its content
+// on demand, even if it's not an observable supplier.
+type ConfigRefreshSupplier interface {
+	ConfigSupplier
+	Refresh() error
+}
+
 // ----------------------------------------------------------------------------
 // config supplier creator
 // ----------------------------------------------------------------------------
@@ -1167,13 +2520,14 @@ func (s *ConfigSource) Get(
 // ----------------------------------------------------------------------------
 
 // ConfigAggregateSource defines a config supplier that aggregates a list of
-// config suppliers into a single aggregate provided supplier.
+// config suppliers into a single aggregate provided supplier. The suppliers
+// are merged in the list order, so the last suppliers take precedence.
 type ConfigAggregateSource struct {
 	ConfigSource
 	suppliers []ConfigSupplier
 }
 
-var _ ConfigSupplier = &ConfigAggregateSource{}
+var _ ConfigObsSupplier = &ConfigAggregateSource{}
 
 // NewConfigAggregateSource will instantiate a new config supplier
 // that aggregate a list of suppliers connections.
@@ -1209,6 +2563,32 @@ func (c *ConfigAggregateSource) load() error {
 	return nil
 }
 
+// Reload will reload all the observable aggregated suppliers, merging
+// again the aggregated content if any of them reported a change.
+// A failing supplier will not prevent the merge of the other suppliers
+// changes, and the first failure is returned.
+func (c *ConfigAggregateSource) Reload() (bool, error) {
+	// reload all the observable aggregated suppliers
+	var failure error
+	reloaded := false
+	for _, supplier := range c.suppliers {
+		if obs, ok := supplier.(ConfigObsSupplier); ok {
+			updated, e := obs.Reload()
+			if e != nil && failure == nil {
+				failure = e
+			}
+			reloaded = reloaded || updated
+		}
+	}
+	// merge the aggregated content if any supplier has changed
+	if reloaded {
+		if e := c.load(); e != nil {
+			return false, e
+		}
+	}
+	return reloaded, failure
+}
+
 // ----------------------------------------------------------------------------
 // config aggregate source creator
 // ----------------------------------------------------------------------------
@@ -1271,6 +2651,7 @@ type ConfigEnvSource struct {
 }
 
 var _ ConfigSupplier = &ConfigEnvSource{}
+var _ ConfigRefreshSupplier = &ConfigEnvSource{}
 
 // NewConfigEnvSource will instantiate a new configuration supplier
 // that will map environmental variables to configuration
@@ -1318,6 +2699,16 @@ func (s *ConfigEnvSource) load() error {
 	return nil
 }
 
+// Refresh will re-read the mapped environment variables values.
+func (s *ConfigEnvSource) Refresh() error {
+	// lock the supplier for changes
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+	// clear the stored values and reload them from the environment
+	s.Partial = ConfigPartial{}
+	return s.load()
+}
+
 // ----------------------------------------------------------------------------
 // config env source creator
 // ----------------------------------------------------------------------------
@@ -1383,10 +2774,258 @@ func (s ConfigEnvSourceCreator) Create(
 	return NewConfigEnvSource(mapping)
 }
 
+// ----------------------------------------------------------------------------
+// config template
+// ----------------------------------------------------------------------------
+
+func configTemplateFuncs(
+	fileSystem afero.Fs,
+) template.FuncMap {
+	return template.FuncMap{
+		// env retrieves an environment variable value
+		"env": os.Getenv,
+		// hostname retrieves the local host name
+		"hostname": os.Hostname,
+		// default returns the default value if the given value is empty
+		"default": func(def, value interface{}) interface{} {
+			if value == nil || reflect.ValueOf(value).IsZero() {
+				return def
+			}
+			return value
+		},
+		// b64enc encodes the given string in base64
+		"b64enc": func(value string) string {
+			return base64.StdEncoding.EncodeToString([]byte(value))
+		},
+		// b64dec decodes the given base64 string
+		"b64dec": func(value string) (string, error) {
+			decoded, e := base64.StdEncoding.DecodeString(value)
+			return string(decoded), e
+		},
+		// file reads a (secret) file content, without the trailing new line
+		"file": func(path string) (string, error) {
+			content, e := afero.ReadFile(fileSystem, path)
+			return strings.TrimRight(string(content), "\r\n"), e
+		},
+	}
+}
+
+func configTemplateRender(
+	fileSystem afero.Fs,
+	path string,
+	reader io.Reader,
+) (io.Reader, error) {
+	// read the template content
+	content, e := io.ReadAll(reader)
+	if e != nil {
+		return nil, e
+	}
+	// parse the file content as a template
+	tmpl, e := template.New(filepath.Base(path)).
+		Option("missingkey=error").
+		Funcs(configTemplateFuncs(fileSystem)).
+		Parse(string(content))
+	if e != nil {
+		return nil, errInvalidConfigTemplate(path, map[string]interface{}{
+			"description": e.Error(),
+		})
+	}
+	// render the template into a new content buffer
+	buffer := &bytes.Buffer{}
+	if e := tmpl.Execute(buffer, nil); e != nil {
+		return nil, errInvalidConfigTemplate(path, map[string]interface{}{
+			"description": e.Error(),
+		})
+	}
+	return buffer, nil
+}
+
+func configTemplateOpen(
+	fileSystem afero.Fs,
+	path string,
+	render bool,
+) (io.Reader, error) {
+	// open the source file
+	file, e := fileSystem.OpenFile(path, os.O_RDONLY, 0o644)
+	if e != nil {
+		return nil, e
+	}
+	if !render {
+		return file, nil
+	}
+	// render the file content, closing the file as it is fully read
+	defer func() { _ = file.Close() }()
+	return configTemplateRender(fileSystem, path, file)
+}
+
+// ----------------------------------------------------------------------------
+// config include
+// ----------------------------------------------------------------------------
+
+type configIncluder struct {
+	fileSystem    afero.Fs
+	parserFactory *ConfigParserFactory
+	template      bool
+	policy        string
+	files         []string
+}
+
+func configFormatFromPath(
+	path,
+	def string,
+) string {
+	// check if the file extension is a known format
+	switch strings.ToLower(strings.TrimPrefix(filepath.Ext(path), ".")) {
+	case ConfigFormatJSON:
+		return ConfigFormatJSON
+	case ConfigFormatYAML, "yml":
+		return ConfigFormatYAML
+	}
+	return def
+}
+
+func (i *configIncluder) load(
+	path,
+	format string,
+	stack []string,
+) (*ConfigPartial, error) {
+	// check for a cyclic inclusion of the file
+	clean := filepath.Clean(path)
+	for _, including := range stack {
+		if including == clean {
+			return nil, errInvalidConfigInclude(path, map[string]interface{}{
+				"description": "include cycle",
+				"stack":       stack,
+			})
+		}
+	}
+	stack = append(append([]string{}, stack...), clean)
+	// open the source file (rendering it, if it's a template)
+	file, e := configTemplateOpen(i.fileSystem, path, i.template)
+	if e != nil {
+		return nil, e
+	}
+	// creates the file content parser instance
+	parser, e := i.parserFactory.Create(format, file)
+	if e != nil {
+		if closer, ok := file.(io.Closer); ok {
+			_ = closer.Close()
+		}
+		return nil, e
+	}
+	defer func() {
+		if closer, ok := parser.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}()
+	// decode the file content
+	partial, e := configParse(parser, i.policy)
+	if e != nil {
+		return nil, e
+	}
+	// resolve the include directives of the decoded content
+	resolved, e := i.resolve(path, format, *partial, stack)
+	if e != nil {
+		return nil, e
+	}
+	return &resolved, nil
+}
+
+func (i *configIncluder) resolve(
+	path,
+	format string,
+	partial ConfigPartial,
+	stack []string,
+) (ConfigPartial, error) {
+	// load the included files, if the partial has an include directive
+	result := ConfigPartial{}
+	if directive, ok := partial[ConfigIncludeKey]; ok {
+		// parse the directive patterns list
+		var patterns []interface{}
+		switch typed := directive.(type) {
+		case string:
+			patterns = []interface{}{typed}
+		case []interface{}:
+			patterns = typed
+		default:
+			return nil, errInvalidConfigInclude(path, map[string]interface{}{
+				"description": "invalid include directive",
+			})
+		}
+		// load and merge the files matching each pattern
+		for _, pattern := range patterns {
+			typed, ok := pattern.(string)
+			if !ok {
+				return nil, errInvalidConfigInclude(path, map[string]interface{}{
+					"description": "invalid include pattern",
+				})
+			}
+			files, e := i.glob(filepath.Dir(path), typed)
+			if e != nil {
+				return nil, e
+			}
+			for _, file := range files {
+				included, e := i.load(file, configFormatFromPath(file, format), stack)
+				if e != nil {
+					return nil, e
+				}
+				i.files = append(i.files, file)
+				result.Merge(*included)
+			}
+		}
+	}
+	// resolve the include directives of the inner partials and
+	// merge the partial content over the included content
+	content := ConfigPartial{}
+	for key, value := range partial {
+		if key == ConfigIncludeKey {
+			continue
+		}
+		if inner, ok := value.(ConfigPartial); ok {
+			resolved, e := i.resolve(path, format, inner, stack)
+			if e != nil {
+				return nil, e
+			}
+			value = resolved
+		}
+		content[key] = value
+	}
+	result.Merge(content)
+	return result, nil
+}
+
+func (i *configIncluder) glob(
+	dir,
+	pattern string,
+) ([]string, error) {
+	// resolve the pattern relative to the including file directory
+	if !filepath.IsAbs(pattern) {
+		pattern = filepath.Join(dir, pattern)
+	}
+	// a pattern without wildcards is a single (required) file
+	if !strings.ContainsAny(pattern, "*?[") {
+		return []string{pattern}, nil
+	}
+	// retrieve the sorted list of matching files
+	files, e := afero.Glob(i.fileSystem, pattern)
+	if e != nil {
+		return nil, e
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
 // ----------------------------------------------------------------------------
 // config file source
 // ----------------------------------------------------------------------------
 
+// ConfigFileOptions defines the optional behaviour of a file config
+// supplier. The template flag will render the file content (and the
+// content of the included files) as a text/template before parsing it.
+type ConfigFileOptions struct {
+	Template bool
+}
+
 // ConfigFileSource defines a config supplier that obtains the config
 // content from a system file.
 type ConfigFileSource struct {
@@ -1395,9 +3034,13 @@ type ConfigFileSource struct {
 	format        string
 	fileSystem    afero.Fs
 	parserFactory *ConfigParserFactory
+	options       ConfigFileOptions
+	policy        string
+	includes      []string
 }
 
 var _ ConfigSupplier = &ConfigFileSource{}
+var _ ConfigRefreshSupplier = &ConfigFileSource{}
 
 // NewConfigFileSource will instantiate a new configuration supplier
 // that will read a file for its configuration info.
@@ -1406,6 +3049,7 @@ func NewConfigFileSource(
 	format string,
 	fileSystem afero.Fs,
 	parserFactory *ConfigParserFactory,
+	options ...ConfigFileOptions,
 ) (*ConfigFileSource, error) {
 	// check file system argument reference
 	if fileSystem == nil {
@@ -1423,6 +3067,9 @@ func NewConfigFileSource(
 		fileSystem:    fileSystem,
 		parserFactory: parserFactory,
 	}
+	if len(options) > 0 {
+		source.options = options[0]
+	}
 	// Load the file config content
 	if e := source.load(); e != nil {
 		return nil, e
@@ -1431,34 +3078,38 @@ func NewConfigFileSource(
 }
 
 func (s *ConfigFileSource) load() error {
-	// open the supplier source file
-	file, e := s.fileSystem.OpenFile(s.path, os.O_RDONLY, 0o644)
-	if e != nil {
-		return e
-	}
-	// creates the file content parser instance
-	parser, e := s.parserFactory.Create(s.format, file)
-	if e != nil {
-		_ = file.Close()
-		return e
-	}
-	defer func() {
-		if closer, ok := parser.(io.Closer); ok {
-			_ = closer.Close()
-		}
-	}()
-	// decode the file content
-	partial, e := parser.Parse()
+	// load the supplier source file, and all the included files
+	includer := &configIncluder{
+		fileSystem:    s.fileSystem,
+		parserFactory: s.parserFactory,
+		template:      s.options.Template,
+		policy:        s.policy,
+	}
+	partial, e := includer.load(s.path, s.format, nil)
 	if e != nil {
 		return e
 	}
 	// store the parsed content into the supplier local config
 	s.Mutex.Lock()
 	s.Partial = *partial
+	s.includes = includer.files
 	s.Mutex.Unlock()
 	return nil
 }
 
+// Refresh will re-read the supplier file content.
+func (s *ConfigFileSource) Refresh() error {
+	return s.load()
+}
+
+func (s *ConfigFileSource) setCase(
+	policy string,
+) error {
+	// re-read the file content with the given case policy
+	s.policy = policy
+	return s.load()
+}
+
 // ----------------------------------------------------------------------------
 // config file source creator
 // ----------------------------------------------------------------------------
@@ -1521,8 +3172,9 @@ func (s ConfigFileSourceCreator) Create(
 	}
 	// retrieve the data from the configuration
 	sConfig := struct {
-		Path   string
-		Format string
+		Path     string
+		Format   string
+		Template bool
 	}{
 		Format: ConfigDefaultFileFormat,
 	}
@@ -1541,6 +3193,7 @@ func (s ConfigFileSourceCreator) Create(
 		sConfig.Format,
 		s.fileSystem,
 		s.parserFactory,
+		ConfigFileOptions{Template: sConfig.Template},
 	)
 }
 
@@ -1554,7 +3207,8 @@ func (s ConfigFileSourceCreator) Create(
 // update the stored configuration information.
 type ConfigObsFileSource struct {
 	ConfigFileSource
-	timestamp time.Time
+	timestamp  time.Time
+	timestamps map[string]time.Time
 }
 
 var _ ConfigSupplier = &ConfigObsFileSource{}
@@ -1568,6 +3222,7 @@ func NewConfigObsFileSource(
 	format string,
 	fileSystem afero.Fs,
 	parserFactory *ConfigParserFactory,
+	options ...ConfigFileOptions,
 ) (*ConfigObsFileSource, error) {
 	// check file system argument reference
 	if fileSystem == nil {
@@ -1588,6 +3243,9 @@ func NewConfigObsFileSource(
 		},
 		timestamp: time.Unix(0, 0),
 	}
+	if len(options) > 0 {
+		source.options = options[0]
+	}
 	// Load the file config content
 	if _, e := source.Reload(); e != nil {
 		return nil, e
@@ -1604,19 +3262,37 @@ func (s *ConfigObsFileSource) Reload() (bool, error) {
 		return false, e
 	}
 	// check if the file modification time is greater than the stored one
+	// or if any of the included files has changed
 	modTime := fileStats.ModTime()
-	if s.timestamp.Equal(time.Unix(0, 0)) || s.timestamp.Before(modTime) {
+	if s.timestamp.Equal(time.Unix(0, 0)) || s.timestamp.Before(modTime) || s.changed() {
 		// load the file content
 		if e := s.load(); e != nil {
 			return false, e
 		}
-		// update the stored config content modification time
+		// update the stored config content modification times
 		s.timestamp = modTime
+		s.timestamps = map[string]time.Time{}
+		for _, include := range s.includes {
+			if fileStats, e := s.fileSystem.Stat(include); e == nil {
+				s.timestamps[include] = fileStats.ModTime()
+			}
+		}
 		return true, nil
 	}
 	return false, nil
 }
 
+func (s *ConfigObsFileSource) changed() bool {
+	// check the included files modification times
+	for include, timestamp := range s.timestamps {
+		fileStats, e := s.fileSystem.Stat(include)
+		if e != nil || !timestamp.Equal(fileStats.ModTime()) {
+			return true
+		}
+	}
+	return false
+}
+
 // ----------------------------------------------------------------------------
 // config observable file source creator
 // ----------------------------------------------------------------------------
@@ -1680,8 +3356,9 @@ func (s ConfigObsFileSourceCreator) Create(
 	}
 	// retrieve the data from the configuration
 	sConfig := struct {
-		Path   string
-		Format string
+		Path     string
+		Format   string
+		Template bool
 	}{
 		Format: ConfigDefaultFileFormat,
 	}
@@ -1700,6 +3377,7 @@ func (s ConfigObsFileSourceCreator) Create(
 		sConfig.Format,
 		s.fileSystem,
 		s.parserFactory,
+		ConfigFileOptions{Template: sConfig.Template},
 	)
 }
 
@@ -1707,9 +3385,26 @@ func (s ConfigObsFileSourceCreator) Create(
 // config dir source
 // ----------------------------------------------------------------------------
 
+// ConfigDirOptions defines the optional behaviour of a dir config
+// supplier. The include and exclude glob patterns filter the loaded
+// files, matched against the file path relative to the supplier
+// directory if the pattern has a path separator, or against the file
+// name otherwise. The namespace flag will mount each file content under
+// a key derived from the file relative path (rdb/primary.yaml will be
+// mounted at rdb.primary). The template flag will render each file
+// content as a text/template before parsing it.
+type ConfigDirOptions struct {
+	Include   []string
+	Exclude   []string
+	Namespace bool
+	Template  bool
+}
+
 // ConfigDirSource defines a config supplier that read all directory files,
 // recursive or not, and parse each one and store all the read content
-// as a config.
+// as a config. The files are loaded in name order, and each one is parsed
+// by the format associated to its extension, falling back to the
+// supplier format.
 type ConfigDirSource struct {
 	ConfigSource
 	path          string
@@ -1717,9 +3412,12 @@ type ConfigDirSource struct {
 	recursive     bool
 	fileSystem    afero.Fs
 	parserFactory *ConfigParserFactory
+	options       ConfigDirOptions
+	policy        string
 }
 
 var _ ConfigSupplier = &ConfigDirSource{}
+var _ ConfigRefreshSupplier = &ConfigDirSource{}
 
 // NewConfigDirSource will instantiate a new configuration supplier
 // that will read a directory files for configuration information.
@@ -1729,6 +3427,7 @@ func NewConfigDirSource(
 	recursive bool,
 	fileSystem afero.Fs,
 	parserFactory *ConfigParserFactory,
+	options ...ConfigDirOptions,
 ) (*ConfigDirSource, error) {
 	// check file system argument reference
 	if fileSystem == nil {
@@ -1747,6 +3446,18 @@ func NewConfigDirSource(
 		fileSystem:    fileSystem,
 		parserFactory: parserFactory,
 	}
+	if len(options) > 0 {
+		source.options = options[0]
+	}
+	// validate the filter patterns
+	for _, pattern := range append(append([]string{}, source.options.Include...), source.options.Exclude...) {
+		if _, e := filepath.Match(pattern, ""); e != nil {
+			return nil, errInvalidConfigSupplier(ConfigPartial{"path": path}, map[string]interface{}{
+				"description": "invalid file pattern",
+				"pattern":     pattern,
+			})
+		}
+	}
 	// load the dir files config content
 	if e := source.load(); e != nil {
 		return nil, e
@@ -1756,7 +3467,7 @@ func NewConfigDirSource(
 
 func (s *ConfigDirSource) load() error {
 	// load the supplier directory contents
-	partial, e := s.loadDir(s.path)
+	partial, e := s.loadDir(s.path, "")
 	if e != nil {
 		return e
 	}
@@ -1767,8 +3478,22 @@ func (s *ConfigDirSource) load() error {
 	return nil
 }
 
+// Refresh will re-read the supplier directory files content.
+func (s *ConfigDirSource) Refresh() error {
+	return s.load()
+}
+
+func (s *ConfigDirSource) setCase(
+	policy string,
+) error {
+	// re-read the directory files content with the given case policy
+	s.policy = policy
+	return s.load()
+}
+
 func (s *ConfigDirSource) loadDir(
-	path string,
+	path,
+	relative string,
 ) (*ConfigPartial, error) {
 	// open the directory stream
 	dir, e := s.fileSystem.Open(path)
@@ -1776,29 +3501,38 @@ func (s *ConfigDirSource) loadDir(
 		return nil, e
 	}
 	defer func() { _ = dir.Close() }()
-	// get the dir entry list
+	// get the dir entry list sorted by name
 	files, e := dir.Readdir(0)
 	if e != nil {
 		return nil, e
 	}
+	names := make([]string, len(files))
+	for i, file := range files {
+		names[i] = file.Name()
+	}
+	sort.Sort(configDirEntrySorter{names: names, files: files})
 	// parse each founded entry
 	loaded := &ConfigPartial{}
-	for _, file := range files {
+	for i, file := range files {
+		name := names[i]
+		if relative != "" {
+			name = relative + "/" + name
+		}
 		// check if is an inner directory
 		if file.IsDir() {
 			// load the founded directory if the supplier is
 			// configured to be recursive
 			if s.recursive {
-				partial, e := s.loadDir(path + "/" + file.Name())
+				partial, e := s.loadDir(filepath.Join(path, names[i]), name)
 				if e != nil {
 					return nil, e
 				}
 				// merge the loaded content
 				loaded.Merge(*partial)
 			}
-		} else {
+		} else if s.accept(name) {
 			// load the file content
-			partial, e := s.loadFile(path + "/" + file.Name())
+			partial, e := s.loadFile(filepath.Join(path, names[i]), name)
 			if e != nil {
 				return nil, e
 			}
@@ -1809,18 +3543,75 @@ func (s *ConfigDirSource) loadDir(
 	return loaded, nil
 }
 
-func (s *ConfigDirSource) loadFile(
+type configDirEntrySorter struct {
+	names []string
+	files []os.FileInfo
+}
+
+func (sorter configDirEntrySorter) Len() int {
+	return len(sorter.names)
+}
+
+func (sorter configDirEntrySorter) Swap(i, j int) {
+	sorter.names[i], sorter.names[j] = sorter.names[j], sorter.names[i]
+	sorter.files[i], sorter.files[j] = sorter.files[j], sorter.files[i]
+}
+
+func (sorter configDirEntrySorter) Less(i, j int) bool {
+	return sorter.names[i] < sorter.names[j]
+}
+
+func (s *ConfigDirSource) accept(
+	relative string,
+) bool {
+	// check if the file matches any of the given patterns
+	matches := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			target := filepath.Base(relative)
+			if strings.Contains(pattern, "/") {
+				target = relative
+			}
+			if ok, _ := filepath.Match(pattern, target); ok {
+				return true
+			}
+		}
+		return false
+	}
+	// check the file against the include and exclude patterns
+	if len(s.options.Include) != 0 && !matches(s.options.Include) {
+		return false
+	}
+	return !matches(s.options.Exclude)
+}
+
+func (s *ConfigDirSource) fileFormat(
 	path string,
+) string {
+	// check if there is a parser that accepts the file extension
+	format := configFormatFromPath(path, strings.ToLower(strings.TrimPrefix(filepath.Ext(path), ".")))
+	for _, creator := range *s.parserFactory {
+		if format != "" && creator.Accept(format) {
+			return format
+		}
+	}
+	return s.format
+}
+
+func (s *ConfigDirSource) loadFile(
+	path,
+	relative string,
 ) (*ConfigPartial, error) {
-	// open the file for reading
-	file, e := s.fileSystem.OpenFile(path, os.O_RDONLY, 0o644)
+	// open the file for reading (rendering it, if it's a template)
+	file, e := configTemplateOpen(s.fileSystem, path, s.options.Template)
 	if e != nil {
 		return nil, e
 	}
 	// get a parser instance to parse the file content
-	parser, e := s.parserFactory.Create(s.format, file)
+	parser, e := s.parserFactory.Create(s.fileFormat(path), file)
 	if e != nil {
-		_ = file.Close()
+		if closer, ok := file.(io.Closer); ok {
+			_ = closer.Close()
+		}
 		return nil, e
 	}
 	defer func() {
@@ -1829,7 +3620,16 @@ func (s *ConfigDirSource) loadFile(
 		}
 	}()
 	// decode the file content
-	return parser.Parse()
+	partial, e := configParse(parser, s.policy)
+	if e != nil || !s.options.Namespace {
+		return partial, e
+	}
+	// mount the file content under the file relative path
+	namespace := ""
+	for _, part := range strings.Split(strings.TrimSuffix(relative, filepath.Ext(relative)), "/") {
+		namespace = configPathKey(namespace, configCaseKey(part, s.policy))
+	}
+	return (&ConfigPartial{}).Set(namespace, *partial)
 }
 
 // ----------------------------------------------------------------------------
@@ -1897,9 +3697,15 @@ func (s ConfigDirSourceCreator) Create(
 		Path      string
 		Format    string
 		Recursive bool
+		Include   interface{}
+		Exclude   interface{}
+		Namespace bool
+		Template  bool
 	}{
 		Format:    ConfigDefaultFileFormat,
 		Recursive: false,
+		Include:   []interface{}{},
+		Exclude:   []interface{}{},
 	}
 	if _, e := config.Populate("", &sConfig); e != nil {
 		return nil, e
@@ -1910,6 +3716,15 @@ func (s ConfigDirSourceCreator) Create(
 			"description": "missing path",
 		})
 	}
+	// parse the file filter patterns (a list or a comma separated string)
+	include, e := configValueStringList(sConfig.Include)
+	if e != nil {
+		return nil, e
+	}
+	exclude, e := configValueStringList(sConfig.Exclude)
+	if e != nil {
+		return nil, e
+	}
 	// create the dir source supplier
 	return NewConfigDirSource(
 		sConfig.Path,
@@ -1917,98 +3732,401 @@ func (s ConfigDirSourceCreator) Create(
 		sConfig.Recursive,
 		s.fileSystem,
 		s.parserFactory,
+		ConfigDirOptions{
+			Include:   include,
+			Exclude:   exclude,
+			Namespace: sConfig.Namespace,
+			Template:  sConfig.Template,
+		},
 	)
 }
 
 // ----------------------------------------------------------------------------
-// config rest source
+// config embedded source creators
 // ----------------------------------------------------------------------------
 
-type configRestRequester interface {
-	Do(req *http.Request) (*http.Response, error)
+func configEmbeddedFileSystem(
+	container *ServiceContainer,
+	config *ConfigPartial,
+) (afero.Fs, error) {
+	// retrieve the file system id from the configuration
+	sConfig := struct{ FS string }{}
+	if _, e := config.Populate("", &sConfig); e != nil {
+		return nil, e
+	}
+	if sConfig.FS == "" {
+		return nil, errInvalidConfigSupplier(*config, map[string]interface{}{
+			"description": "missing fs",
+		})
+	}
+	// retrieve the file system registered in the container
+	entry, e := container.Get(sConfig.FS)
+	if e != nil {
+		return nil, e
+	}
+	fileSystem, ok := entry.(fs.FS)
+	if !ok {
+		return nil, errConversion(entry, "fs.FS")
+	}
+	return afero.FromIOFS{FS: fileSystem}, nil
 }
 
-// ConfigRestSource defines a config supplier that read a REST service and
-// store a section of the response as the stored config.
-type ConfigRestSource struct {
-	ConfigSource
-	client        configRestRequester
-	uri           string
-	format        string
+// ConfigEmbeddedFileSourceCreator defines a supplier creator used to
+// instantiate a file config supplier that reads the file from a fs.FS
+// (like an embed.FS) registered in the container.
+type ConfigEmbeddedFileSourceCreator struct {
+	container     *ServiceContainer
 	parserFactory *ConfigParserFactory
-	configPath    string
 }
 
-var _ ConfigSupplier = &ConfigRestSource{}
+var _ ConfigSupplierCreator = &ConfigEmbeddedFileSourceCreator{}
 
-// NewConfigRestSource will instantiate a new configuration supplier
-// that will read a REST endpoint for configuration info.
-func NewConfigRestSource(
-	client configRestRequester,
-	uri,
-	format string,
+// NewConfigEmbeddedFileSourceCreator instantiates a new embedded file
+// config supplier creator.
+func NewConfigEmbeddedFileSourceCreator(
+	container *ServiceContainer,
 	parserFactory *ConfigParserFactory,
-	configPath string,
-) (*ConfigRestSource, error) {
-	// check client argument reference
-	if client == nil {
-		return nil, errNilPointer("client")
+) (*ConfigEmbeddedFileSourceCreator, error) {
+	// check the container argument reference
+	if container == nil {
+		return nil, errNilPointer("container")
 	}
-	// check parser factory argument reference
+	// check the parser factory argument reference
 	if parserFactory == nil {
 		return nil, errNilPointer("parserFactory")
 	}
-	// instantiates the config supplier
-	source := &ConfigRestSource{
-		ConfigSource:  *NewConfigSource(),
-		client:        client,
-		uri:           uri,
-		format:        format,
+	// instantiate the strategy
+	return &ConfigEmbeddedFileSourceCreator{
+		container:     container,
 		parserFactory: parserFactory,
-		configPath:    configPath,
-	}
-	// load the config information from the REST service
-	if e := source.load(); e != nil {
-		return nil, e
-	}
-	return source, nil
+	}, nil
 }
 
-func (s *ConfigRestSource) load() error {
-	// get the REST service information
-	config, e := s.request()
-	if e != nil {
-		return e
-	}
-	// retrieve the config information from the service response data
-	partial, e := config.Partial(s.configPath)
-	if e != nil {
+// Accept will check if the requested supplier can be instantiated by this
+// creator by parsing the given config partial.
+func (s ConfigEmbeddedFileSourceCreator) Accept(
+	config *ConfigPartial,
+) bool {
+	// check the config argument reference
+	if config == nil {
+		return false
+	}
+	// retrieve the data from the configuration
+	sConfig := struct{ Type string }{}
+	if _, e := config.Populate("", &sConfig); e != nil {
+		return false
+	}
+	// return acceptance for the read config type
+	return sConfig.Type == ConfigTypeEmbeddedFile
+}
+
+// Create will instantiate the desired embedded file supplier instance.
+func (s ConfigEmbeddedFileSourceCreator) Create(
+	config *ConfigPartial,
+) (ConfigSupplier, error) {
+	// check the config argument reference
+	if config == nil {
+		return nil, errNilPointer("config")
+	}
+	// retrieve the file system to be used
+	fileSystem, e := configEmbeddedFileSystem(s.container, config)
+	if e != nil {
+		return nil, e
+	}
+	// create the file supplier over the retrieved file system
+	supplier, e := ConfigFileSourceCreator{
+		fileSystem:    fileSystem,
+		parserFactory: s.parserFactory,
+	}.Create(config)
+	if e != nil {
+		return nil, e
+	}
+	return supplier, nil
+}
+
+// ConfigEmbeddedDirSourceCreator defines a supplier creator used to
+// instantiate a dir config supplier that reads the files from a fs.FS
+// (like an embed.FS) registered in the container.
+type ConfigEmbeddedDirSourceCreator struct {
+	container     *ServiceContainer
+	parserFactory *ConfigParserFactory
+}
+
+var _ ConfigSupplierCreator = &ConfigEmbeddedDirSourceCreator{}
+
+// NewConfigEmbeddedDirSourceCreator instantiates a new embedded dir
+// config supplier creator.
+func NewConfigEmbeddedDirSourceCreator(
+	container *ServiceContainer,
+	parserFactory *ConfigParserFactory,
+) (*ConfigEmbeddedDirSourceCreator, error) {
+	// check the container argument reference
+	if container == nil {
+		return nil, errNilPointer("container")
+	}
+	// check the parser factory argument reference
+	if parserFactory == nil {
+		return nil, errNilPointer("parserFactory")
+	}
+	// instantiate the strategy
+	return &ConfigEmbeddedDirSourceCreator{
+		container:     container,
+		parserFactory: parserFactory,
+	}, nil
+}
+
+// Accept will check if the requested supplier can be instantiated by this
+// creator by parsing the given config partial.
+func (s ConfigEmbeddedDirSourceCreator) Accept(
+	config *ConfigPartial,
+) bool {
+	// check the config argument reference
+	if config == nil {
+		return false
+	}
+	// retrieve the data from the configuration
+	sConfig := struct{ Type string }{}
+	if _, e := config.Populate("", &sConfig); e != nil {
+		return false
+	}
+	// return acceptance for the read config type
+	return sConfig.Type == ConfigTypeEmbeddedDir
+}
+
+// Create will instantiate the desired embedded dir supplier instance.
+func (s ConfigEmbeddedDirSourceCreator) Create(
+	config *ConfigPartial,
+) (ConfigSupplier, error) {
+	// check the config argument reference
+	if config == nil {
+		return nil, errNilPointer("config")
+	}
+	// retrieve the file system to be used
+	fileSystem, e := configEmbeddedFileSystem(s.container, config)
+	if e != nil {
+		return nil, e
+	}
+	// create the dir supplier over the retrieved file system
+	supplier, e := ConfigDirSourceCreator{
+		fileSystem:    fileSystem,
+		parserFactory: s.parserFactory,
+	}.Create(config)
+	if e != nil {
+		return nil, e
+	}
+	return supplier, nil
+}
+
+// ----------------------------------------------------------------------------
+// config rest source
+// ----------------------------------------------------------------------------
+
+type configRestRequester interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// ConfigRestAuth defines the authentication information that a REST
+// config supplier will add to the service requests.
+type ConfigRestAuth struct {
+	Type     string
+	Token    string
+	Username string
+	Password string
+}
+
+// ConfigRestRetry defines the retry policy of a REST config supplier
+// service request. The delay between attempts is doubled after each
+// failed attempt, bounded by the ConfigRestRetryMinDelay and
+// ConfigRestRetryMaxDelay values.
+type ConfigRestRetry struct {
+	Attempts int
+	Delay    time.Duration
+}
+
+// ConfigRestRequest defines the extra request options used by a REST
+// config supplier when calling the remote service. The context, if
+// given, cancels the supplier requests and retry waits when done.
+type ConfigRestRequest struct {
+	Context context.Context
+	Headers map[string]string
+	Auth    ConfigRestAuth
+	Retry   ConfigRestRetry
+}
+
+// ConfigRestSource defines a config supplier that read a REST service and
+// store a section of the response as the stored config.
+type ConfigRestSource struct {
+	ConfigSource
+	client        configRestRequester
+	uri           string
+	format        string
+	parserFactory *ConfigParserFactory
+	configPath    string
+	options       ConfigRestRequest
+	policy        string
+	validators    configRestValidators
+	ctx           context.Context
+	cancel        context.CancelFunc
+}
+
+// configRestValidators holds the response validators used to issue
+// conditional requests to the REST service.
+type configRestValidators struct {
+	etag         string
+	lastModified string
+}
+
+var _ ConfigSupplier = &ConfigRestSource{}
+var _ io.Closer = &ConfigRestSource{}
+
+// NewConfigRestSource will instantiate a new configuration supplier
+// that will read a REST endpoint for configuration info.
+func NewConfigRestSource(
+	client configRestRequester,
+	uri,
+	format string,
+	parserFactory *ConfigParserFactory,
+	configPath string,
+	options ...ConfigRestRequest,
+) (*ConfigRestSource, error) {
+	// check client argument reference
+	if client == nil {
+		return nil, errNilPointer("client")
+	}
+	// check parser factory argument reference
+	if parserFactory == nil {
+		return nil, errNilPointer("parserFactory")
+	}
+	// instantiates the config supplier
+	source := &ConfigRestSource{
+		ConfigSource:  *NewConfigSource(),
+		client:        client,
+		uri:           uri,
+		format:        format,
+		parserFactory: parserFactory,
+		configPath:    configPath,
+	}
+	if len(options) > 0 {
+		source.options = options[0]
+	}
+	source.ctx, source.cancel = configRestContext(source.options)
+	// load the config information from the REST service
+	if e := source.load(); e != nil {
+		source.cancel()
+		return nil, e
+	}
+	return source, nil
+}
+
+// Close will cancel the supplier pending requests and retry waits.
+func (s *ConfigRestSource) Close() error {
+	s.cancel()
+	return nil
+}
+
+func (s *ConfigRestSource) setCase(
+	policy string,
+) error {
+	// discard the response validators so the service content is
+	// re-requested and parsed with the given case policy
+	s.Mutex.Lock()
+	s.policy = policy
+	s.validators = configRestValidators{}
+	s.Mutex.Unlock()
+	return s.load()
+}
+
+func configRestContext(
+	options ConfigRestRequest,
+) (context.Context, context.CancelFunc) {
+	// derive the supplier context from the requested one, if any
+	ctx := options.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithCancel(ctx)
+}
+
+func (s *ConfigRestSource) load() error {
+	// get the REST service information
+	config, validators, modified, e := s.request()
+	if e != nil || !modified {
+		return e
+	}
+	// retrieve the config information from the service response data
+	partial, e := config.Partial(s.configPath)
+	if e != nil {
 		return e
 	}
-	// store the retrieved config
+	// store the retrieved config and the response validators
 	s.Mutex.Lock()
 	s.Partial = partial
+	s.validators = validators
 	s.Mutex.Unlock()
 	return nil
 }
 
-func (s *ConfigRestSource) request() (*ConfigPartial, error) {
+func (s *ConfigRestSource) request() (*ConfigPartial, configRestValidators, bool, error) {
+	// call the REST service, retrying the request if the
+	// supplier has been configured to do so
+	minDelay := time.Duration(ConfigRestRetryMinDelay) * time.Millisecond
+	maxDelay := time.Duration(ConfigRestRetryMaxDelay) * time.Millisecond
+	delay := s.options.Retry.Delay
+	for attempt := 0; ; attempt++ {
+		config, validators, modified, retry, e := s.requestAttempt()
+		if !retry || attempt >= s.options.Retry.Attempts {
+			return config, validators, modified, e
+		}
+		// wait for the next attempt, doubling the bounded delay
+		if delay < minDelay {
+			delay = minDelay
+		}
+		if delay > maxDelay {
+			delay = maxDelay
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-s.ctx.Done():
+			timer.Stop()
+			return nil, configRestValidators{}, false, s.ctx.Err()
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
+
+func (s *ConfigRestSource) requestAttempt() (*ConfigPartial, configRestValidators, bool, bool, error) {
 	var e error
 	// create the REST service config request
 	var req *http.Request
-	if req, e = http.NewRequest(http.MethodGet, s.uri, http.NoBody); e != nil {
-		return nil, e
+	if req, e = http.NewRequestWithContext(s.ctx, http.MethodGet, s.uri, http.NoBody); e != nil {
+		return nil, configRestValidators{}, false, false, e
 	}
+	s.prepare(req)
 	// call the REST service for the configuration information
 	var res *http.Response
 	if res, e = s.client.Do(req); e != nil {
-		return nil, e
+		return nil, configRestValidators{}, false, true, e
+	}
+	defer func() { _ = res.Body.Close() }()
+	data, e := io.ReadAll(res.Body)
+	if e != nil {
+		return nil, configRestValidators{}, false, true, e
+	}
+	// check the response status code
+	switch {
+	case res.StatusCode == http.StatusNotModified:
+		return nil, configRestValidators{}, false, false, nil
+	case res.StatusCode < 200 || res.StatusCode > 299:
+		retry := res.StatusCode >= 500 || res.StatusCode == http.StatusTooManyRequests
+		return nil, configRestValidators{}, false, retry, errInvalidConfigRestResponse(res.StatusCode, map[string]interface{}{
+			"uri": s.uri,
+		})
 	}
-	data, _ := io.ReadAll(res.Body)
 	// gat a parser to parse the received service data
 	parser, e := s.parserFactory.Create(s.format, bytes.NewReader(data))
 	if e != nil {
-		return nil, e
+		return nil, configRestValidators{}, false, false, e
 	}
 	defer func() {
 		if closer, ok := parser.(io.Closer); ok {
@@ -2016,7 +4134,41 @@ func (s *ConfigRestSource) request() (*ConfigPartial, error) {
 		}
 	}()
 	// parse the data into a config instance
-	return parser.Parse()
+	config, e := configParse(parser, s.policy)
+	if e != nil {
+		return nil, configRestValidators{}, false, false, e
+	}
+	// retrieve the response validators, only to be stored for the next
+	// conditional request after the response content has been applied
+	validators := configRestValidators{}
+	if res.Header != nil {
+		validators.etag = res.Header.Get("ETag")
+		validators.lastModified = res.Header.Get("Last-Modified")
+	}
+	return config, validators, true, false, nil
+}
+
+func (s *ConfigRestSource) prepare(
+	req *http.Request,
+) {
+	// add the configured request headers
+	for name, value := range s.options.Headers {
+		req.Header.Set(name, value)
+	}
+	// add the authentication header
+	switch strings.ToLower(s.options.Auth.Type) {
+	case ConfigRestAuthBearer:
+		req.Header.Set("Authorization", "Bearer "+s.options.Auth.Token)
+	case ConfigRestAuthBasic:
+		req.SetBasicAuth(s.options.Auth.Username, s.options.Auth.Password)
+	}
+	// add the conditional request headers
+	if s.validators.etag != "" {
+		req.Header.Set("If-None-Match", s.validators.etag)
+	}
+	if s.validators.lastModified != "" {
+		req.Header.Set("If-Modified-Since", s.validators.lastModified)
+	}
 }
 
 // ----------------------------------------------------------------------------
@@ -2026,16 +4178,19 @@ func (s *ConfigRestSource) request() (*ConfigPartial, error) {
 // ConfigRestSourceCreator defines a supplier creator used to instantiate
 // a REST service config supplier.
 type ConfigRestSourceCreator struct {
-	clientFactory func() configRestRequester
+	clientFactory func(timeout time.Duration, tlsConfig *tls.Config) configRestRequester
 	parserFactory *ConfigParserFactory
+	fileSystem    afero.Fs
 }
 
 var _ ConfigSupplierCreator = &ConfigRestSourceCreator{}
 
 // NewConfigRestSourceCreator instantiates a new REST service config
-// supplier creator.
+// supplier creator. The optional file system is used to read the TLS
+// certificate files (defaults to the OS file system).
 func NewConfigRestSourceCreator(
 	parserFactory *ConfigParserFactory,
+	fileSystem ...afero.Fs,
 ) (*ConfigRestSourceCreator, error) {
 	// check the parser factory argument reference
 	if parserFactory == nil {
@@ -2043,8 +4198,9 @@ func NewConfigRestSourceCreator(
 	}
 	// instantiate the strategy
 	return &ConfigRestSourceCreator{
-		clientFactory: func() configRestRequester { return &http.Client{} },
+		clientFactory: newConfigRestClient,
 		parserFactory: parserFactory,
+		fileSystem:    configRestFileSystem(fileSystem),
 	}, nil
 }
 
@@ -2098,16 +4254,145 @@ func (s ConfigRestSourceCreator) Create(
 			"description": "missing response config path",
 		})
 	}
+	// create the client and request options
+	client, options, e := s.client(config)
+	if e != nil {
+		return nil, e
+	}
 	// create the requested rest config supplier
 	return NewConfigRestSource(
-		s.clientFactory(),
+		client,
 		sConfig.URI,
 		sConfig.Format,
 		s.parserFactory,
 		sConfig.Path.Config,
+		*options,
 	)
 }
 
+func (s ConfigRestSourceCreator) client(
+	config *ConfigPartial,
+) (configRestRequester, *ConfigRestRequest, error) {
+	// retrieve the connection data from the configuration
+	sConfig := struct {
+		Timeout int
+		Headers ConfigPartial
+		Auth    struct {
+			Type     string
+			Token    string
+			Username string
+			Password string
+			Env      struct {
+				Token    string
+				Username string
+				Password string
+			}
+		}
+		Retry struct {
+			Attempts int
+			Delay    int
+		}
+		TLS struct {
+			CA       string
+			Cert     string
+			Key      string
+			Insecure bool
+		}
+	}{}
+	if _, e := config.Populate("", &sConfig); e != nil {
+		return nil, nil, e
+	}
+	// compose the request options
+	options := &ConfigRestRequest{
+		Headers: map[string]string{},
+		Auth: ConfigRestAuth{
+			Type:     sConfig.Auth.Type,
+			Token:    EnvString(sConfig.Auth.Env.Token, sConfig.Auth.Token),
+			Username: EnvString(sConfig.Auth.Env.Username, sConfig.Auth.Username),
+			Password: EnvString(sConfig.Auth.Env.Password, sConfig.Auth.Password),
+		},
+		Retry: ConfigRestRetry{
+			Attempts: sConfig.Retry.Attempts,
+			Delay:    time.Duration(sConfig.Retry.Delay) * time.Millisecond,
+		},
+	}
+	for k, value := range sConfig.Headers {
+		typedKey, ok := k.(string)
+		if !ok {
+			return nil, nil, errConversion(k, "string")
+		}
+		typedValue, ok := value.(string)
+		if !ok {
+			return nil, nil, errConversion(value, "string")
+		}
+		options.Headers[typedKey] = typedValue
+	}
+	// validate the client certificate pair definition
+	if (sConfig.TLS.Cert == "") != (sConfig.TLS.Key == "") {
+		return nil, nil, errInvalidConfigSupplier(*config, map[string]interface{}{
+			"description": "TLS client certificate requires both cert and key",
+		})
+	}
+	// compose the client TLS configuration
+	var tlsConfig *tls.Config
+	if sConfig.TLS.CA != "" || sConfig.TLS.Cert != "" || sConfig.TLS.Insecure {
+		tlsConfig = &tls.Config{
+			InsecureSkipVerify: sConfig.TLS.Insecure, //nolint:gosec
+		}
+		if sConfig.TLS.CA != "" {
+			ca, e := afero.ReadFile(s.fileSystem, sConfig.TLS.CA)
+			if e != nil {
+				return nil, nil, e
+			}
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+				return nil, nil, errInvalidConfigSupplier(*config, map[string]interface{}{
+					"description": "invalid TLS CA certificate",
+				})
+			}
+		}
+		if sConfig.TLS.Cert != "" {
+			certPEM, e := afero.ReadFile(s.fileSystem, sConfig.TLS.Cert)
+			if e != nil {
+				return nil, nil, e
+			}
+			keyPEM, e := afero.ReadFile(s.fileSystem, sConfig.TLS.Key)
+			if e != nil {
+				return nil, nil, e
+			}
+			cert, e := tls.X509KeyPair(certPEM, keyPEM)
+			if e != nil {
+				return nil, nil, e
+			}
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+	}
+	// create the client
+	timeout := time.Duration(sConfig.Timeout) * time.Millisecond
+	return s.clientFactory(timeout, tlsConfig), options, nil
+}
+
+func configRestFileSystem(
+	fileSystem []afero.Fs,
+) afero.Fs {
+	// use the given file system, falling back to the OS file system
+	if len(fileSystem) > 0 && fileSystem[0] != nil {
+		return fileSystem[0]
+	}
+	return afero.NewOsFs()
+}
+
+func newConfigRestClient(
+	timeout time.Duration,
+	tlsConfig *tls.Config,
+) configRestRequester {
+	client := &http.Client{Timeout: timeout}
+	if tlsConfig != nil {
+		client.Transport = &http.Transport{TLSClientConfig: tlsConfig}
+	}
+	return client
+}
+
 // ----------------------------------------------------------------------------
 // config observable rest source
 // ----------------------------------------------------------------------------
@@ -2117,12 +4402,22 @@ func (s ConfigRestSourceCreator) Create(
 // Also, the REST service will be periodically checked for updates.
 type ConfigObsRestSource struct {
 	ConfigRestSource
+	strategy      string
 	timestampPath string
-	timestamp     time.Time
+	revision      interface{}
 }
 
 var _ ConfigObsSupplier = &ConfigObsRestSource{}
 
+// ConfigObsRestOptions defines the optional behaviour of an observable
+// REST config supplier. The strategy defines the change detection method
+// (defaults to the ConfigDefaultRestTimestamp strategy), and the request
+// defines the options used when calling the REST service.
+type ConfigObsRestOptions struct {
+	Strategy string
+	Request  ConfigRestRequest
+}
+
 // NewConfigObsRestSource will instantiate a new configuration supplier
 // that will read a REST endpoint for configuration info, opening the
 // possibility for on-the-fly update on supplier content change.
@@ -2133,6 +4428,7 @@ func NewConfigObsRestSource(
 	parserFactory *ConfigParserFactory,
 	timestampPath,
 	configPath string,
+	options ...ConfigObsRestOptions,
 ) (*ConfigObsRestSource, error) {
 	// check client argument reference
 	if client == nil {
@@ -2142,6 +4438,23 @@ func NewConfigObsRestSource(
 	if parserFactory == nil {
 		return nil, errNilPointer("parserFactory")
 	}
+	// check the change detection strategy
+	opts := ConfigObsRestOptions{Strategy: ConfigDefaultRestTimestamp}
+	if len(options) > 0 {
+		opts = options[0]
+		if opts.Strategy == "" {
+			opts.Strategy = ConfigDefaultRestTimestamp
+		}
+	}
+	switch opts.Strategy {
+	case ConfigRestTimestampRFC3339,
+		ConfigRestTimestampEpoch,
+		ConfigRestTimestampEpochMillis,
+		ConfigRestTimestampVersion,
+		ConfigRestTimestampHash:
+	default:
+		return nil, errInvalidConfigRestTimestamp(opts.Strategy)
+	}
 	// instantiates the config supplier
 	source := &ConfigObsRestSource{
 		ConfigRestSource: ConfigRestSource{
@@ -2151,12 +4464,16 @@ func NewConfigObsRestSource(
 			format:        format,
 			parserFactory: parserFactory,
 			configPath:    configPath,
+			options:       opts.Request,
 		},
+		strategy:      opts.Strategy,
 		timestampPath: timestampPath,
-		timestamp:     time.Unix(0, 0),
+		revision:      nil,
 	}
+	source.ctx, source.cancel = configRestContext(opts.Request)
 	// load the config information from the REST service
 	if _, e := source.Reload(); e != nil {
+		source.cancel()
 		return nil, e
 	}
 	return source, nil
@@ -2166,51 +4483,150 @@ func NewConfigObsRestSource(
 // supplier configuration content.
 func (s *ConfigObsRestSource) Reload() (bool, error) {
 	// get the REST service information
-	config, e := s.request()
-	if e != nil {
+	config, validators, modified, e := s.request()
+	if e != nil || !modified {
 		return false, e
 	}
-	// search for the response timestamp
-	var timestamp time.Time
-	if timestamp, e = s.searchTimestamp(config); e != nil {
+	// search for the response revision
+	var revision interface{}
+	if revision, e = s.searchRevision(config); e != nil {
 		return false, e
 	}
-	// check if the response timestamp is greater than the locally stored
-	// config information timestamp
-	if s.timestamp.Equal(time.Unix(0, 0)) || s.timestamp.Before(timestamp) {
+	// check if the response revision is newer than the locally stored
+	// config information revision
+	if s.revision == nil || s.isNewer(revision) {
 		// get the response config information
 		partial, e := config.Partial(s.configPath)
 		if e != nil {
 			return false, e
 		}
-		// store the loaded config information and response timestamp
+		// store the loaded config information, response revision
+		// and response validators
 		s.Mutex.Lock()
 		s.Partial = partial
-		s.timestamp = timestamp
+		s.revision = revision
+		s.validators = validators
 		s.Mutex.Unlock()
 		return true, nil
 	}
+	// the response content is already applied
+	s.Mutex.Lock()
+	s.validators = validators
+	s.Mutex.Unlock()
 	return false, nil
 }
 
-func (s *ConfigObsRestSource) searchTimestamp(
+func (s *ConfigObsRestSource) setCase(
+	policy string,
+) error {
+	// discard the response validators and revision so the service
+	// content is re-requested and parsed with the given case policy
+	s.Mutex.Lock()
+	s.policy = policy
+	s.validators = configRestValidators{}
+	s.revision = nil
+	s.Mutex.Unlock()
+	_, e := s.Reload()
+	return e
+}
+
+func (s *ConfigObsRestSource) searchRevision(
 	config *ConfigPartial,
-) (time.Time, error) {
-	// retrieve the timestamp information from the parsed response data
-	responseTimestamp, e := config.String(s.timestampPath)
-	if e != nil {
-		return time.Now(), e
+) (interface{}, error) {
+	// the hash strategy don't rely on a response field
+	if s.strategy == ConfigRestTimestampHash {
+		partial, e := config.Get(s.configPath)
+		if e != nil {
+			return nil, e
+		}
+		return configHash(partial), nil
 	}
-	// parse the timestamp string
-	var timestamp time.Time
-	if timestamp, e = time.Parse(time.RFC3339, responseTimestamp); e != nil {
-		return time.Now(), e
+	// retrieve the revision information from the parsed response data
+	value, e := config.Get(s.timestampPath)
+	if e != nil {
+		return nil, e
 	}
-	return timestamp, nil
-}
-
-// ----------------------------------------------------------------------------
-// config observable rest source creator
+	switch s.strategy {
+	case ConfigRestTimestampRFC3339:
+		// parse the timestamp string
+		typedValue, ok := value.(string)
+		if !ok {
+			return nil, errConversion(value, "string")
+		}
+		return time.Parse(time.RFC3339, typedValue)
+	case ConfigRestTimestampEpoch:
+		seconds, e := configValueInt64(value)
+		if e != nil {
+			return nil, e
+		}
+		return time.Unix(seconds, 0), nil
+	case ConfigRestTimestampEpochMillis:
+		millis, e := configValueInt64(value)
+		if e != nil {
+			return nil, e
+		}
+		return time.UnixMilli(millis), nil
+	default:
+		return configValueInt64(value)
+	}
+}
+
+func (s *ConfigObsRestSource) isNewer(
+	revision interface{},
+) bool {
+	switch current := s.revision.(type) {
+	case time.Time:
+		return current.Before(revision.(time.Time))
+	case int64:
+		return current < revision.(int64)
+	default:
+		return current != revision
+	}
+}
+
+func configHash(
+	value interface{},
+) string {
+	// recursive deterministic writer declaration
+	var write func(w io.Writer, value interface{})
+	write = func(w io.Writer, value interface{}) {
+		switch typedValue := value.(type) {
+		case ConfigPartial:
+			// write the partial entries sorted by key
+			keys := make([]string, 0, len(typedValue))
+			index := map[string]interface{}{}
+			for k, v := range typedValue {
+				key := fmt.Sprintf("%v", k)
+				keys = append(keys, key)
+				index[key] = v
+			}
+			sort.Strings(keys)
+			_, _ = io.WriteString(w, "{")
+			for _, key := range keys {
+				_, _ = fmt.Fprintf(w, "%q:", key)
+				write(w, index[key])
+				_, _ = io.WriteString(w, ",")
+			}
+			_, _ = io.WriteString(w, "}")
+		case []interface{}:
+			_, _ = io.WriteString(w, "[")
+			for _, v := range typedValue {
+				write(w, v)
+				_, _ = io.WriteString(w, ",")
+			}
+			_, _ = io.WriteString(w, "]")
+		default:
+			_, _ = fmt.Fprintf(w, "%T:%v", value, value)
+		}
+	}
+	// hash the written value
+	hash := sha256.New()
+	write(hash, value)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// ----------------------------------------------------------------------------
+// config observable rest source creator
 // ----------------------------------------------------------------------------
 
 // ConfigObsRestSourceCreator defines a supplier creator used to instantiate
@@ -2222,9 +4638,11 @@ type ConfigObsRestSourceCreator struct {
 var _ ConfigSupplierCreator = &ConfigObsRestSourceCreator{}
 
 // NewConfigObsRestSourceCreator instantiates a new observable REST
-// config supplier creator service.
+// config supplier creator service. The optional file system is used to
+// read the TLS certificate files (defaults to the OS file system).
 func NewConfigObsRestSourceCreator(
 	parserFactory *ConfigParserFactory,
+	fileSystem ...afero.Fs,
 ) (*ConfigObsRestSourceCreator, error) {
 	// check the decoder factory argument reference
 	if parserFactory == nil {
@@ -2233,8 +4651,9 @@ func NewConfigObsRestSourceCreator(
 	// instantiate the strategy
 	return &ConfigObsRestSourceCreator{
 		ConfigRestSourceCreator: ConfigRestSourceCreator{
-			clientFactory: func() configRestRequester { return &http.Client{} },
+			clientFactory: newConfigRestClient,
 			parserFactory: parserFactory,
+			fileSystem:    configRestFileSystem(fileSystem),
 		},
 	}, nil
 }
@@ -2267,14 +4686,16 @@ func (s ConfigObsRestSourceCreator) Create(
 	}
 	// retrieve the data from the configuration
 	sConfig := struct {
-		URI    string
-		Format string
-		Path   struct {
+		URI      string
+		Format   string
+		Strategy string
+		Path     struct {
 			Config    string
 			Timestamp string
 		}
 	}{
-		Format: ConfigDefaultRestFormat,
+		Format:   ConfigDefaultRestFormat,
+		Strategy: ConfigDefaultRestTimestamp,
 	}
 	if _, e := config.Populate("", &sConfig); e != nil {
 		return nil, e
@@ -2290,508 +4711,2932 @@ func (s ConfigObsRestSourceCreator) Create(
 			"description": "missing response config path",
 		})
 	}
-	if sConfig.Path.Timestamp == "" {
+	if sConfig.Path.Timestamp == "" && sConfig.Strategy != ConfigRestTimestampHash {
 		return nil, errInvalidConfigSupplier(*config, map[string]interface{}{
 			"description": "missing response config timestamp",
 		})
 	}
+	// create the client and request options
+	client, options, e := s.client(config)
+	if e != nil {
+		return nil, e
+	}
 	// create the observable rest config supplier
 	return NewConfigObsRestSource(
-		s.clientFactory(),
+		client,
 		sConfig.URI,
 		sConfig.Format,
 		s.parserFactory,
 		sConfig.Path.Timestamp,
 		sConfig.Path.Config,
+		ConfigObsRestOptions{
+			Strategy: sConfig.Strategy,
+			Request:  *options,
+		},
 	)
 }
 
 // ----------------------------------------------------------------------------
-// config observer
-// ----------------------------------------------------------------------------
-
-// ConfigObserver callback function used to be called when an observed
-// configuration path has changed.
-type ConfigObserver func(old, new interface{})
-
-// ----------------------------------------------------------------------------
-// config
+// config command source
 // ----------------------------------------------------------------------------
 
-type configSupplierRef struct {
-	id       string
-	priority int
-	supplier ConfigSupplier
+// ConfigCommandSource defines a config supplier that runs a local
+// executable and parses its standard output as the config content.
+// The executable is re-executed on every reload, and the content is
+// only updated when the output hash changes.
+type ConfigCommandSource struct {
+	ConfigSource
+	command       string
+	args          []string
+	timeout       time.Duration
+	format        string
+	parserFactory *ConfigParserFactory
+	policy        string
+	hash          string
 }
 
-type configSupplierRefSorter []configSupplierRef
+var _ ConfigSupplier = &ConfigCommandSource{}
+var _ ConfigObsSupplier = &ConfigCommandSource{}
 
-func (supplier configSupplierRefSorter) Len() int {
-	return len(supplier)
+// NewConfigCommandSource will instantiate a new configuration supplier
+// that will run a local executable for its configuration info.
+func NewConfigCommandSource(
+	command string,
+	args []string,
+	timeout time.Duration,
+	format string,
+	parserFactory *ConfigParserFactory,
+) (*ConfigCommandSource, error) {
+	// check parser factory argument reference
+	if parserFactory == nil {
+		return nil, errNilPointer("parserFactory")
+	}
+	// instantiates the config supplier
+	source := &ConfigCommandSource{
+		ConfigSource:  *NewConfigSource(),
+		command:       command,
+		args:          args,
+		timeout:       timeout,
+		format:        format,
+		parserFactory: parserFactory,
+	}
+	// load the command output config content
+	if _, e := source.Reload(); e != nil {
+		return nil, e
+	}
+	return source, nil
 }
 
-func (supplier configSupplierRefSorter) Swap(i, j int) {
-	supplier[i], supplier[j] = supplier[j], supplier[i]
+// Reload will re-execute the supplier command, and, if the output has
+// changed, reload the supplier config content.
+func (s *ConfigCommandSource) Reload() (bool, error) {
+	// run the command and check the output hash
+	output, e := s.run()
+	if e != nil {
+		return false, e
+	}
+	sum := sha256.Sum256(output)
+	hash := hex.EncodeToString(sum[:])
+	if hash == s.hash {
+		return false, nil
+	}
+	// get a parser to parse the command output
+	parser, e := s.parserFactory.Create(s.format, bytes.NewReader(output))
+	if e != nil {
+		return false, e
+	}
+	defer func() {
+		if closer, ok := parser.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}()
+	partial, e := configParse(parser, s.policy)
+	if e != nil {
+		return false, e
+	}
+	// store the parsed content and the output hash
+	s.Mutex.Lock()
+	s.Partial = *partial
+	s.hash = hash
+	s.Mutex.Unlock()
+	return true, nil
 }
 
-func (supplier configSupplierRefSorter) Less(i, j int) bool {
-	return supplier[i].priority < supplier[j].priority
+func (s *ConfigCommandSource) setCase(
+	policy string,
+) error {
+	// discard the output hash so the command output is parsed again
+	// with the given case policy
+	s.policy = policy
+	s.hash = ""
+	_, e := s.Reload()
+	return e
+}
+
+func (s *ConfigCommandSource) run() ([]byte, error) {
+	// limit the command execution time, if requested
+	ctx := context.Background()
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+	// execute the command capturing the output streams
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd := exec.CommandContext(ctx, s.command, s.args...)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if e := cmd.Run(); e != nil {
+		description := e.Error()
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			description = "timeout"
+		}
+		return nil, errConfigCommandFailed(s.command, stderr.String(), map[string]interface{}{
+			"args":        s.args,
+			"description": description,
+		})
+	}
+	return stdout.Bytes(), nil
 }
 
-type configObserverRef struct {
-	path     string
-	current  interface{}
-	callback ConfigObserver
+// ----------------------------------------------------------------------------
+// config command source creator
+// ----------------------------------------------------------------------------
+
+// ConfigCommandSourceCreator defines a supplier creator used to
+// instantiate a command output config supplier.
+type ConfigCommandSourceCreator struct {
+	parserFactory *ConfigParserFactory
 }
 
-// Config defines an object responsible to handle several config suppliers
-// and enable config content observers by path.
-type Config struct {
-	suppliers []configSupplierRef
-	observers []configObserverRef
-	partial   *ConfigPartial
-	mutex     sync.Locker
-	observer  Trigger
+var _ ConfigSupplierCreator = &ConfigCommandSourceCreator{}
+
+// NewConfigCommandSourceCreator instantiates a new command output
+// config supplier creator.
+func NewConfigCommandSourceCreator(
+	parserFactory *ConfigParserFactory,
+) (*ConfigCommandSourceCreator, error) {
+	// check the parser factory argument reference
+	if parserFactory == nil {
+		return nil, errNilPointer("parserFactory")
+	}
+	// instantiate the creator
+	return &ConfigCommandSourceCreator{
+		parserFactory: parserFactory,
+	}, nil
 }
 
-// NewConfig instantiate a new configuration object.
-// This object will handle a series of suppliers, alongside with the ability
-// of registration of config path/values observer callbacks that will be
-// called whenever the value has changed.
-func NewConfig() *Config {
-	// instantiate the config
-	c := &Config{
-		suppliers: []configSupplierRef{},
-		observers: []configObserverRef{},
-		partial:   &ConfigPartial{},
-		mutex:     &sync.Mutex{},
-		observer:  nil,
+// Accept will check if the requested supplier can be instantiated by this
+// creator by parsing the given config partial.
+func (s ConfigCommandSourceCreator) Accept(
+	config *ConfigPartial,
+) bool {
+	// check the config argument reference
+	if config == nil {
+		return false
 	}
-	// check if there is a need to create the observable suppliers
-	// trigger
-	period := time.Duration(ConfigObserveFrequency) * time.Millisecond
-	if period != 0 {
-		// create the trigger used to poll the observable suppliers
-		c.observer, _ = NewTriggerRecurring(period, func() error {
-			return c.reload()
-		})
+	// retrieve the data from the configuration
+	sConfig := struct{ Type string }{}
+	if _, e := config.Populate("", &sConfig); e != nil {
+		return false
 	}
-	return c
+	// return acceptance for the read config type
+	return sConfig.Type == ConfigTypeCommand
 }
 
-// Close terminates the config instance.
-// This will stop the observer trigger and call close on
-// all registered suppliers.
-func (c *Config) Close() error {
-	// lock the config for handling
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
-	// iterate through all the config suppliers checking if we can close then
-	for _, ref := range c.suppliers {
-		// check if the iterated supplier implements the closer interface
-		if source, ok := ref.supplier.(io.Closer); ok {
-			// close the supplier
-			if e := source.Close(); e != nil {
-				return e
-			}
-		}
+// Create will instantiate the desired command supplier instance.
+func (s ConfigCommandSourceCreator) Create(
+	config *ConfigPartial,
+) (ConfigSupplier, error) {
+	// check the config argument reference
+	if config == nil {
+		return nil, errNilPointer("config")
 	}
-	// check if a trigger was generated on creation
-	// for observable suppliers polling
-	if c.observer != nil {
-		// terminate the trigger
-		if e := c.observer.Close(); e != nil {
-			return e
+	// retrieve the data from the configuration
+	sConfig := struct {
+		Command string
+		Args    interface{}
+		Timeout int
+		Format  string
+	}{
+		Args:    []interface{}{},
+		Timeout: ConfigDefaultCommandTimeout,
+		Format:  ConfigDefaultCommandFormat,
+	}
+	if _, e := config.Populate("", &sConfig); e != nil {
+		return nil, e
+	}
+	// validate configuration
+	if sConfig.Command == "" {
+		return nil, errInvalidConfigSupplier(*config, map[string]interface{}{
+			"description": "missing command",
+		})
+	}
+	// parse the command arguments list
+	args, ok := sConfig.Args.([]interface{})
+	if !ok {
+		return nil, errConversion(sConfig.Args, "[]interface{}")
+	}
+	typedArgs := make([]string, 0, len(args))
+	for _, arg := range args {
+		switch arg.(type) {
+		case ConfigPartial, []interface{}, nil:
+			return nil, errConversion(arg, "string")
 		}
-		c.observer = nil
+		typedArgs = append(typedArgs, fmt.Sprintf("%v", arg))
 	}
-	return nil
+	// create the command config supplier
+	return NewConfigCommandSource(
+		sConfig.Command,
+		typedArgs,
+		time.Duration(sConfig.Timeout)*time.Millisecond,
+		sConfig.Format,
+		s.parserFactory,
+	)
 }
 
-// Entries will retrieve the list of stored config entries.
-func (c *Config) Entries() []string {
-	// lock the config for handling
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
-	// retrieve the stored entries list
-	return c.partial.Entries()
-}
+// ----------------------------------------------------------------------------
+// config override source
+// ----------------------------------------------------------------------------
 
-// Has will check if a path has been loaded.
-// This means that if the values has been loaded by any registered supplier.
-func (c *Config) Has(
-	path string,
-) bool {
-	// lock the config for handling
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
-	// check if the requested path exists in the stored partial
-	return c.partial.Has(path)
+// ConfigOverrideSource defines a config supplier that holds the values
+// programmatically set at runtime, optionally persisted into a file.
+type ConfigOverrideSource struct {
+	ConfigSource
+	path       string
+	format     string
+	fileSystem afero.Fs
 }
 
-// Get will retrieve a configuration value loaded from a supplier.
-func (c *Config) Get(
-	path string,
-	def ...interface{},
-) (interface{}, error) {
-	// lock the config for handling
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
-	// try to retrieve the requested value
-	return c.partial.Get(path, def...)
-}
+var _ ConfigSupplier = &ConfigOverrideSource{}
 
-// Bool will retrieve a bool configuration value loaded from a supplier.
-func (c *Config) Bool(
-	path string,
-	def ...bool,
-) (bool, error) {
-	// lock the config for handling
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
-	// try to retrieve a boolean value from the local partial
-	return c.partial.Bool(path, def...)
+// NewConfigOverrideSource will instantiate a new empty configuration
+// override supplier.
+func NewConfigOverrideSource() *ConfigOverrideSource {
+	return &ConfigOverrideSource{
+		ConfigSource: *NewConfigSource(),
+	}
 }
 
-// Int will retrieve an integer configuration value loaded from a supplier.
-func (c *Config) Int(
+// Set will store a value in the requested path of the override content,
+// persisting the override content if a persistence file was defined.
+func (s *ConfigOverrideSource) Set(
 	path string,
-	def ...int,
-) (int, error) {
-	// lock the config for handling
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
-	// try to retrieve an integer value from the local partial
-	return c.partial.Int(path, def...)
+	value interface{},
+) error {
+	// store the value in the supplier content
+	if e := s.update(func(partial *ConfigPartial) error {
+		_, e := partial.Set(path, ConfigConvert(value))
+		return e
+	}); e != nil {
+		return e
+	}
+	return s.Save()
 }
 
-// Float will retrieve a floating point configuration value loaded
-// from a supplier.
-func (c *Config) Float(
+// Unset will remove the requested path from the override content,
+// persisting the override content if a persistence file was defined.
+func (s *ConfigOverrideSource) Unset(
 	path string,
-	def ...float64,
-) (float64, error) {
-	// lock the config for handling
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
-	// try to retrieve a float value from the local partial
-	return c.partial.Float(path, def...)
+) error {
+	// remove the path from the supplier content
+	if e := s.update(func(partial *ConfigPartial) error {
+		return partial.unset(path)
+	}); e != nil {
+		return e
+	}
+	return s.Save()
 }
 
-// String will retrieve a string configuration value loaded from a supplier.
-func (c *Config) String(
+// Persist will define the file used to persist the override content,
+// loading the file content into the supplier if the file already exists.
+func (s *ConfigOverrideSource) Persist(
 	path string,
-	def ...string,
-) (string, error) {
+	fileSystem afero.Fs,
+	parserFactory *ConfigParserFactory,
+) error {
+	// check file system argument reference
+	if fileSystem == nil {
+		return errNilPointer("fileSystem")
+	}
+	// check parser factory argument reference
+	if parserFactory == nil {
+		return errNilPointer("parserFactory")
+	}
+	// load the persisted content if the file exists
+	format := configFormatFromPath(path, ConfigFormatYAML)
+	partial := &ConfigPartial{}
+	if exists, _ := afero.Exists(fileSystem, path); exists {
+		includer := &configIncluder{fileSystem: fileSystem, parserFactory: parserFactory}
+		loaded, e := includer.load(path, format, nil)
+		if e != nil {
+			return e
+		}
+		partial = loaded
+	}
+	// store the persistence information and the loaded content
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+	s.path = path
+	s.format = format
+	s.fileSystem = fileSystem
+	s.Partial.Merge(*partial)
+	return nil
+}
+
+// Save will write the override content into the persistence file,
+// if one was defined.
+func (s *ConfigOverrideSource) Save() error {
+	// lock the supplier for handling
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+	// check if there is a persistence file
+	if s.fileSystem == nil {
+		return nil
+	}
+	// serialize the supplier content and write it to the file
+	exporter, _ := newConfigExporter(ConfigExportOptions{}, nil)
+	content, e := exporter.export(s.format, s.Partial)
+	if e != nil {
+		return e
+	}
+	return afero.WriteFile(s.fileSystem, s.path, content, 0o644)
+}
+
+func (s *ConfigOverrideSource) update(
+	change func(partial *ConfigPartial) error,
+) error {
+	// lock the supplier for handling
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+	// apply the change over a copy of the content, so a failed
+	// change don't leave the supplier partially changed
+	partial := s.Partial.Clone()
+	if e := change(&partial); e != nil {
+		return e
+	}
+	s.Partial = partial
+	return nil
+}
+
+func (s *ConfigOverrideSource) snapshot() ConfigPartial {
+	// lock the supplier for handling
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+	// retrieve a copy of the supplier content
+	return s.Partial.Clone()
+}
+
+func (s *ConfigOverrideSource) restore(
+	partial ConfigPartial,
+) {
+	// lock the supplier for handling
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+	// replace the supplier content
+	s.Partial = partial
+}
+
+// ----------------------------------------------------------------------------
+// config cache source
+// ----------------------------------------------------------------------------
+
+// ConfigCacheSource defines a config supplier that decorates another
+// supplier by keeping a last-known-good copy of its content in a file,
+// updated whenever the decorated supplier is successfully (re)loaded.
+type ConfigCacheSource struct {
+	supplier   ConfigSupplier
+	path       string
+	format     string
+	fileSystem afero.Fs
+}
+
+var _ ConfigObsSupplier = &ConfigCacheSource{}
+var _ ConfigRefreshSupplier = &ConfigCacheSource{}
+
+// NewConfigCacheSource will instantiate a new config supplier that
+// stores the content of the given supplier in the given cache file.
+func NewConfigCacheSource(
+	supplier ConfigSupplier,
+	path string,
+	fileSystem afero.Fs,
+) (*ConfigCacheSource, error) {
+	// check the supplier argument reference
+	if supplier == nil {
+		return nil, errNilPointer("supplier")
+	}
+	// check file system argument reference
+	if fileSystem == nil {
+		return nil, errNilPointer("fileSystem")
+	}
+	// instantiate the supplier and store the initial cache copy
+	s := &ConfigCacheSource{
+		supplier:   supplier,
+		path:       path,
+		format:     configFormatFromPath(path, ConfigFormatYAML),
+		fileSystem: fileSystem,
+	}
+	if e := s.save(); e != nil {
+		return nil, e
+	}
+	return s, nil
+}
+
+// Has will check if the requested path is present in the decorated
+// supplier content.
+func (s *ConfigCacheSource) Has(
+	path string,
+) bool {
+	return s.supplier.Has(path)
+}
+
+// Get will retrieve the value stored in the requested path of the
+// decorated supplier content.
+func (s *ConfigCacheSource) Get(
+	path string,
+	def ...interface{},
+) (interface{}, error) {
+	return s.supplier.Get(path, def...)
+}
+
+// Reload will reload the decorated supplier, if observable, and update
+// the cache copy if the content has changed.
+func (s *ConfigCacheSource) Reload() (bool, error) {
+	// check if the decorated supplier is observable
+	supplier, ok := s.supplier.(ConfigObsSupplier)
+	if !ok {
+		return false, nil
+	}
+	// reload the decorated supplier, keeping the cache copy untouched
+	// if the reload failed
+	updated, e := supplier.Reload()
+	if e != nil || !updated {
+		return updated, e
+	}
+	return true, s.save()
+}
+
+// Refresh will force the decorated supplier to re-read its content,
+// if refreshable, and update the cache copy.
+func (s *ConfigCacheSource) Refresh() error {
+	// check if the decorated supplier can be refreshed, falling back
+	// to a reload of the supplier
+	supplier, ok := s.supplier.(ConfigRefreshSupplier)
+	if !ok {
+		_, e := s.Reload()
+		return e
+	}
+	// refresh the decorated supplier and update the cache copy
+	if e := supplier.Refresh(); e != nil {
+		return e
+	}
+	return s.save()
+}
+
+// Close will close the decorated supplier, if closable.
+func (s *ConfigCacheSource) Close() error {
+	if supplier, ok := s.supplier.(io.Closer); ok {
+		return supplier.Close()
+	}
+	return nil
+}
+
+func (s *ConfigCacheSource) save() error {
+	// retrieve the decorated supplier content
+	content, e := s.supplier.Get("")
+	if e != nil {
+		return e
+	}
+	partial, ok := content.(ConfigPartial)
+	if !ok {
+		return errConversion(content, "ConfigPartial")
+	}
+	// serialize the content and write it to the cache file
+	exporter, _ := newConfigExporter(ConfigExportOptions{}, nil)
+	data, e := exporter.export(s.format, partial)
+	if e != nil {
+		return e
+	}
+	if e := s.fileSystem.MkdirAll(filepath.Dir(s.path), 0o755); e != nil {
+		return e
+	}
+	return afero.WriteFile(s.fileSystem, s.path, data, 0o644)
+}
+
+// ----------------------------------------------------------------------------
+// config case source
+// ----------------------------------------------------------------------------
+
+// ConfigCaseSource defines a config supplier that decorates another
+// supplier by applying its own keys case policy over the decorated
+// supplier content, instead of the config-wide one. The file, directory,
+// REST, command and database suppliers re-parse their content with the
+// decorator policy, so the original keys case can be preserved even if
+// the config-wide policy lowercases them. The content of any other
+// supplier can only be lowercased. The insensitive policy makes the
+// decorator lookups case-insensitive, but the lookups over the merged
+// config content follow the config-wide policy.
+type ConfigCaseSource struct {
+	ConfigSource
+	supplier ConfigSupplier
+	policy   string
+}
+
+type configCaseSupplier interface {
+	setCase(policy string) error
+}
+
+var _ ConfigObsSupplier = &ConfigCaseSource{}
+var _ ConfigRefreshSupplier = &ConfigCaseSource{}
+
+// NewConfigCaseSource will instantiate a new config supplier that
+// applies the given case policy over the given supplier content.
+func NewConfigCaseSource(
+	supplier ConfigSupplier,
+	policy string,
+) (*ConfigCaseSource, error) {
+	// check the supplier argument reference
+	if supplier == nil {
+		return nil, errNilPointer("supplier")
+	}
+	// check the case policy argument value
+	switch policy {
+	case ConfigCaseLower, ConfigCasePreserve, ConfigCaseInsensitive:
+	default:
+		return nil, errInvalidConfigCase(policy)
+	}
+	// re-parse the decorated supplier content with the requested policy,
+	// if the supplier parses its content
+	if caseSupplier, ok := supplier.(configCaseSupplier); ok {
+		if e := caseSupplier.setCase(policy); e != nil {
+			return nil, e
+		}
+	}
+	// instantiate the supplier and normalize the decorated content
+	s := &ConfigCaseSource{
+		ConfigSource: ConfigSource{
+			Mutex:   &sync.Mutex{},
+			Partial: ConfigPartial{},
+		},
+		supplier: supplier,
+		policy:   policy,
+	}
+	if e := s.load(); e != nil {
+		return nil, e
+	}
+	return s, nil
+}
+
+// Has will check if the requested path is present in the decorated
+// supplier content, ignoring the keys case if the policy is insensitive.
+func (s *ConfigCaseSource) Has(
+	path string,
+) bool {
+	return s.ConfigSource.Has(s.lookup(path))
+}
+
+// Get will retrieve the value stored in the requested path of the
+// decorated supplier content, ignoring the keys case if the policy is
+// insensitive.
+func (s *ConfigCaseSource) Get(
+	path string,
+	def ...interface{},
+) (interface{}, error) {
+	return s.ConfigSource.Get(s.lookup(path), def...)
+}
+
+// Reload will reload the decorated supplier, if observable, and
+// normalize the reloaded content.
+func (s *ConfigCaseSource) Reload() (bool, error) {
+	// check if the decorated supplier is observable
+	supplier, ok := s.supplier.(ConfigObsSupplier)
+	if !ok {
+		return false, nil
+	}
+	// reload the decorated supplier and normalize the changed content
+	updated, e := supplier.Reload()
+	if updated {
+		if e := s.load(); e != nil {
+			return false, e
+		}
+	}
+	return updated, e
+}
+
+// Refresh will force the decorated supplier to re-read its content,
+// if refreshable, and normalize the refreshed content.
+func (s *ConfigCaseSource) Refresh() error {
+	// check if the decorated supplier can be refreshed, falling back
+	// to a reload of the supplier
+	supplier, ok := s.supplier.(ConfigRefreshSupplier)
+	if !ok {
+		_, e := s.Reload()
+		return e
+	}
+	// refresh the decorated supplier and normalize the refreshed content
+	if e := supplier.Refresh(); e != nil {
+		return e
+	}
+	return s.load()
+}
+
+// Close will close the decorated supplier, if closable.
+func (s *ConfigCaseSource) Close() error {
+	if supplier, ok := s.supplier.(io.Closer); ok {
+		return supplier.Close()
+	}
+	return nil
+}
+
+func (s *ConfigCaseSource) load() error {
+	// retrieve the decorated supplier content
+	content, e := s.supplier.Get("")
+	if e != nil {
+		return e
+	}
+	partial, ok := content.(ConfigPartial)
+	if !ok {
+		return errConversion(content, "ConfigPartial")
+	}
+	// lowercase the content keys if requested by the policy
+	if s.policy == ConfigCaseLower {
+		partial = configCaseConvert(partial).(ConfigPartial)
+	}
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+	s.Partial = partial
+	return nil
+}
+
+func (s *ConfigCaseSource) lookup(
+	path string,
+) string {
+	// check if the lookups should ignore the keys case
+	if s.policy != ConfigCaseInsensitive {
+		return path
+	}
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+	return configCasePath(s.Partial, path)
+}
+
+func configCasePath(
+	partial ConfigPartial,
+	path string,
+) string {
+	// retrieve the path segments
+	segments, e := configPathSegments(path)
+	if e != nil {
+		return path
+	}
+	// resolve each segment key to the stored key that only differs
+	// in case, if any
+	var it interface{} = partial
+	for i, segment := range segments {
+		if list, index, ok := configPathList(it, segment); ok {
+			if index >= len(list) {
+				return path
+			}
+			it = list[index]
+			continue
+		}
+		typedIt, ok := it.(ConfigPartial)
+		if !ok || segment.indexed {
+			return path
+		}
+		key, ok := configCasePartialKey(typedIt, segment.key, ConfigCaseInsensitive)
+		if !ok {
+			return path
+		}
+		segments[i].key = key.(string)
+		it = typedIt[key]
+	}
+	return configPathString(segments)
+}
+
+func configCaseConvert(
+	value interface{},
+) interface{} {
+	// recursively lowercase the value keys
+	switch typedValue := value.(type) {
+	case ConfigPartial:
+		result := ConfigPartial{}
+		for k, v := range typedValue {
+			if key, ok := k.(string); ok {
+				k = strings.ToLower(key)
+			}
+			result[k] = configCaseConvert(v)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(typedValue))
+		for i, v := range typedValue {
+			result[i] = configCaseConvert(v)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+// ----------------------------------------------------------------------------
+// config observer
+// ----------------------------------------------------------------------------
+
+// ConfigObserver callback function used to be called when an observed
+// configuration path has changed.
+type ConfigObserver func(old, new interface{})
+
+// ConfigChange defines a change of a configuration leaf path value.
+type ConfigChange struct {
+	Path string
+	Old  interface{}
+	New  interface{}
+}
+
+// ConfigEvent defines the information given to an event observer when
+// an observed configuration path has changed. The old and new values are
+// the ones stored in the observed path, or in the path prefix before the
+// first wildcard if the observed path is a wildcard path.
+type ConfigEvent struct {
+	Path    string
+	Old     interface{}
+	New     interface{}
+	Changes []ConfigChange
+}
+
+// ConfigEventObserver callback function used to be called when an
+// observed configuration path has changed, receiving the list of
+// changed leaf paths.
+type ConfigEventObserver func(event ConfigEvent)
+
+// ConfigObserverHandle defines the identifier of an observer registration
+// that can be used to remove that specific registration.
+type ConfigObserverHandle uint64
+
+func configPathParts(
+	path string,
+) []string {
+	// split the path in its canonical segments, or use the full path
+	// as a single segment if malformed
+	segments, e := configPathSegments(path)
+	if e != nil {
+		return []string{path}
+	}
+	var parts []string
+	for _, segment := range segments {
+		parts = append(parts, configPathString([]configPathSegment{segment}))
+	}
+	return parts
+}
+
+func configPathStatic(
+	pattern string,
+) (string, bool) {
+	// retrieve the pattern prefix before the first wildcard
+	segments, e := configPathSegments(pattern)
+	if e != nil {
+		return pattern, false
+	}
+	for i, segment := range segments {
+		if !segment.indexed && (segment.key == "*" || segment.key == "**") {
+			return configPathString(segments[:i]), true
+		}
+	}
+	return pattern, false
+}
+
+func configPathMatch(
+	pattern,
+	path string,
+) bool {
+	// check if the path is in the sub-tree of a path that matches the
+	// given pattern, where a single star matches any path part and a
+	// double star matches any number of path parts
+	patternParts := configPathParts(pattern)
+	pathParts := configPathParts(path)
+	for i, part := range patternParts {
+		switch {
+		case part == "**":
+			return true
+		case i >= len(pathParts):
+			return false
+		case part != "*" && !configKeyEqual(part, pathParts[i]):
+			return false
+		}
+	}
+	return true
+}
+
+func configLeafs(
+	value interface{},
+) map[string]interface{} {
+	// recursive leaf flattening function declaration
+	var flatten func(prefix string, value interface{}, leafs map[string]interface{})
+	flatten = func(prefix string, value interface{}, leafs map[string]interface{}) {
+		partial, ok := value.(ConfigPartial)
+		if !ok || (len(partial) == 0 && prefix != "") {
+			if value != nil {
+				leafs[prefix] = value
+			}
+			return
+		}
+		for k, v := range partial {
+			flatten(configPathKey(prefix, k), v, leafs)
+		}
+	}
+	// flatten the value
+	leafs := map[string]interface{}{}
+	flatten("", value, leafs)
+	return leafs
+}
+
+func configDiff(
+	old,
+	new interface{},
+) []ConfigChange {
+	// flatten both values
+	oldLeafs := configLeafs(old)
+	newLeafs := configLeafs(new)
+	// compose the sorted list of changed leafs
+	var changes []ConfigChange
+	for path, value := range oldLeafs {
+		if !reflect.DeepEqual(value, newLeafs[path]) {
+			changes = append(changes, ConfigChange{Path: path, Old: value, New: newLeafs[path]})
+		}
+	}
+	for path, value := range newLeafs {
+		if _, ok := oldLeafs[path]; !ok {
+			changes = append(changes, ConfigChange{Path: path, Old: nil, New: value})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
+
+func configMaskedPath(
+	exporter *configExporter,
+	path string,
+) bool {
+	// check if any of the path parts matches the exporter mask patterns
+	for _, part := range configPathParts(path) {
+		if exporter.masked(part) {
+			return true
+		}
+	}
+	return false
+}
+
+func configMaskChanges(
+	exporter *configExporter,
+	changes []ConfigChange,
+) []ConfigChange {
+	// hide the values of the changed paths with a masked key
+	masked := make([]ConfigChange, len(changes))
+	for i, change := range changes {
+		masked[i] = change
+		if exporter == nil || !configMaskedPath(exporter, change.Path) {
+			continue
+		}
+		if change.Old != nil {
+			masked[i].Old = ConfigExportMaskValue
+		}
+		if change.New != nil {
+			masked[i].New = ConfigExportMaskValue
+		}
+	}
+	return masked
+}
+
+// ----------------------------------------------------------------------------
+// config explanation
+// ----------------------------------------------------------------------------
+
+// ConfigOrigin defines a supplier that defined a configuration path value.
+type ConfigOrigin struct {
+	Supplier string
+	Priority int
+	Value    interface{}
+}
+
+// ConfigExplanation defines the provenance information of a configuration
+// path value. It holds the supplier that provided the resulting value and
+// the list of lower priority suppliers that also defined the path,
+// ordered from the highest to the lowest priority. A partial value also
+// holds the explanation of each one of its leafs, and is flagged as
+// merged (with no supplier) if its leafs come from several suppliers.
+type ConfigExplanation struct {
+	Path       string
+	Value      interface{}
+	Supplier   string
+	Priority   int
+	Overridden []ConfigOrigin
+	Merged     bool
+	Leafs      []ConfigExplanation
+}
+
+// String will render the explanation as a single text line.
+func (e ConfigExplanation) String() string {
+	if e.Merged {
+		return fmt.Sprintf("%s = %v (merged)", e.Path, e.Value)
+	}
+	line := fmt.Sprintf("%s = %v (%s:%d)", e.Path, e.Value, e.Supplier, e.Priority)
+	for _, origin := range e.Overridden {
+		line += fmt.Sprintf(" overrides %v (%s:%d)", origin.Value, origin.Supplier, origin.Priority)
+	}
+	return line
+}
+
+// ----------------------------------------------------------------------------
+// config export
+// ----------------------------------------------------------------------------
+
+// ConfigExportOptions defines the options used when exporting the
+// merged configuration. The mask list holds the regular expression
+// patterns, matched case-insensitively against the keys, of the values
+// that should be masked. The annotate flag will add the supplier that
+// provided each leaf value as a comment (YAML format only).
+type ConfigExportOptions struct {
+	Mask     []string
+	Annotate bool
+}
+
+type configExporter struct {
+	masks    []*regexp.Regexp
+	annotate bool
+	explain  func(path string) *ConfigExplanation
+}
+
+func newConfigExporter(
+	options ConfigExportOptions,
+	explain func(path string) *ConfigExplanation,
+) (*configExporter, error) {
+	// compile the mask patterns
+	exporter := &configExporter{annotate: options.Annotate, explain: explain}
+	for _, pattern := range options.Mask {
+		mask, e := regexp.Compile("(?i)" + pattern)
+		if e != nil {
+			return nil, e
+		}
+		exporter.masks = append(exporter.masks, mask)
+	}
+	return exporter, nil
+}
+
+func (x *configExporter) export(
+	format string,
+	partial ConfigPartial,
+) ([]byte, error) {
+	// serialize the partial into the requested format
+	switch format {
+	case ConfigFormatJSON:
+		return json.MarshalIndent(x.json(partial), "", "  ")
+	case ConfigFormatYAML:
+		node, e := x.yaml("", partial)
+		if e != nil {
+			return nil, e
+		}
+		buffer := &bytes.Buffer{}
+		encoder := yaml.NewEncoder(buffer)
+		encoder.SetIndent(2)
+		if e := encoder.Encode(node); e != nil {
+			return nil, e
+		}
+		_ = encoder.Close()
+		return buffer.Bytes(), nil
+	default:
+		return nil, errInvalidConfigFormat(format)
+	}
+}
+
+func (x *configExporter) masked(
+	key string,
+) bool {
+	// check if the key matches any of the mask patterns
+	for _, mask := range x.masks {
+		if mask.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+func (x *configExporter) sorted(
+	partial ConfigPartial,
+) ([]string, map[string]interface{}) {
+	// index the partial entries by the string version of the keys
+	keys := make([]string, 0, len(partial))
+	index := map[string]interface{}{}
+	for k, v := range partial {
+		key := fmt.Sprintf("%v", k)
+		keys = append(keys, key)
+		index[key] = v
+	}
+	sort.Strings(keys)
+	return keys, index
+}
+
+func (x *configExporter) json(
+	value interface{},
+) interface{} {
+	// convert the value into a JSON encoding friendly value
+	// (the JSON encoder sorts the map keys)
+	switch typedValue := value.(type) {
+	case ConfigPartial:
+		keys, index := x.sorted(typedValue)
+		result := map[string]interface{}{}
+		for _, key := range keys {
+			if x.masked(key) {
+				result[key] = ConfigExportMaskValue
+			} else {
+				result[key] = x.json(index[key])
+			}
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, 0, len(typedValue))
+		for _, v := range typedValue {
+			result = append(result, x.json(v))
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+func (x *configExporter) yaml(
+	path string,
+	value interface{},
+) (*yaml.Node, error) {
+	// convert the value into an ordered YAML node tree
+	switch typedValue := value.(type) {
+	case ConfigPartial:
+		node := &yaml.Node{Kind: yaml.MappingNode}
+		keys, index := x.sorted(typedValue)
+		for _, key := range keys {
+			keyPath := configPathKey(path, key)
+			keyNode := &yaml.Node{Kind: yaml.ScalarNode, Value: key}
+			var valueNode *yaml.Node
+			var e error
+			if x.masked(key) {
+				valueNode, e = x.yaml(keyPath, ConfigExportMaskValue)
+			} else {
+				valueNode, e = x.yaml(keyPath, index[key])
+			}
+			if e != nil {
+				return nil, e
+			}
+			// annotate the leaf values with the supplier information
+			if x.annotate && valueNode.Kind != yaml.MappingNode {
+				if explanation := x.explain(keyPath); explanation.Supplier != "" {
+					keyNode.LineComment = fmt.Sprintf("%s (%d)", explanation.Supplier, explanation.Priority)
+				}
+			}
+			node.Content = append(node.Content, keyNode, valueNode)
+		}
+		return node, nil
+	case []interface{}:
+		node := &yaml.Node{Kind: yaml.SequenceNode}
+		for _, v := range typedValue {
+			item, e := x.yaml(path, v)
+			if e != nil {
+				return nil, e
+			}
+			node.Content = append(node.Content, item)
+		}
+		return node, nil
+	default:
+		node := &yaml.Node{}
+		if e := node.Encode(value); e != nil {
+			return nil, e
+		}
+		return node, nil
+	}
+}
+
+// ----------------------------------------------------------------------------
+// config binding
+// ----------------------------------------------------------------------------
+
+// ConfigBindOptions defines the options of a configuration binding.
+// The OnChange callback is called after every refresh of the bound
+// value, the Validator can reject a populated value, keeping the
+// previous one, and the ErrorHandler is called with any rejected
+// refresh of the bound value.
+type ConfigBindOptions struct {
+	OnChange     func(old, new interface{})
+	Validator    func(value interface{}) error
+	ErrorHandler ConfigErrorHandler
+}
+
+// ConfigBinding defines a value populated from a configuration path
+// that is kept up to date with the changes of the path content.
+type ConfigBinding struct {
+	config   *Config
+	handle   ConfigObserverHandle
+	defaults reflect.Value
+	options  ConfigBindOptions
+	value    atomic.Value
+}
+
+// Load will atomically retrieve the latest populated value.
+func (b *ConfigBinding) Load() interface{} {
+	return b.value.Load()
+}
+
+// Close will stop the binding from receiving configuration updates.
+func (b *ConfigBinding) Close() {
+	b.config.RemoveObserverHandle(b.handle)
+}
+
+func (b *ConfigBinding) populate(
+	value interface{},
+) (interface{}, error) {
+	// populate a copy of the binding default value
+	target := reflect.New(b.defaults.Type()).Elem()
+	target.Set(b.defaults)
+	if _, e := (&ConfigPartial{}).populate(value, target, true); e != nil {
+		return nil, e
+	}
+	result := target.Interface()
+	// validate the populated value
+	if b.options.Validator != nil {
+		if e := b.options.Validator(result); e != nil {
+			return nil, e
+		}
+	}
+	return result, nil
+}
+
+func (b *ConfigBinding) update(
+	event ConfigEvent,
+) {
+	// populate the new bound value
+	value, e := b.populate(event.New)
+	if e != nil {
+		if b.options.ErrorHandler != nil {
+			b.options.ErrorHandler(e)
+		}
+		return
+	}
+	// store the populated value and notify the change
+	old := b.value.Swap(value)
+	if b.options.OnChange != nil {
+		b.options.OnChange(old, value)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// config typed observer
+// ----------------------------------------------------------------------------
+
+// ConfigObserveOptions defines the options of a typed configuration
+// observer.
+type ConfigObserveOptions struct {
+	// Immediate requests the observer to be called once on registration
+	// with the current path value (and the zero value as the old value).
+	Immediate bool
+
+	// ErrorHandler is called with the errors of the conversion of the
+	// observed values. If not given, the conversion errors are reported
+	// to the config error handlers, or recorded as config warnings if no
+	// error handler is registered.
+	ErrorHandler ConfigErrorHandler
+}
+
+// ObserveAs register a new typed observer to a configuration path. The
+// old and new path values are populated into the observer type using the
+// same rules as Populate, and the conversion errors are reported to the
+// error handler instead of calling the observer.
+func ObserveAs[T any](
+	config *Config,
+	path string,
+	callback func(old, new T),
+	options ...ConfigObserveOptions,
+) (ConfigObserverHandle, error) {
+	// check the config argument reference
+	if config == nil {
+		return 0, errNilPointer("config")
+	}
+	// validate the callback argument reference
+	if callback == nil {
+		return 0, errNilPointer("callback")
+	}
+	opts := ConfigObserveOptions{}
+	if len(options) > 0 {
+		opts = options[0]
+	}
+	// observer that converts the event values before calling the
+	// typed callback (called while holding the config lock)
+	observer := func(event ConfigEvent) {
+		old, e := configObserveValue[T](event.Old)
+		if e == nil {
+			var current T
+			if current, e = configObserveValue[T](event.New); e == nil {
+				callback(old, current)
+				return
+			}
+		}
+		// queue the conversion error to be reported after the config
+		// lock is released
+		config.queue(e, opts.ErrorHandler)
+	}
+	// lock the config for handling, so no change is lost between
+	// the registration and the immediate call (reporting the queued
+	// errors after releasing the lock)
+	defer config.flush()
+	config.mutex.Lock()
+	defer config.mutex.Unlock()
+	// check if the requested path (or the wildcard path prefix) is present
+	path = configKey(path)
+	static, _ := configPathStatic(path)
+	current, e := config.partial.Get(static)
+	if e != nil {
+		return 0, e
+	}
+	// register the observer and call it with the current value if requested
+	handle := config.observe(path, observer)
+	if opts.Immediate {
+		observer(ConfigEvent{Path: path, New: current})
+	}
+	return handle, nil
+}
+
+func configObserveValue[T any](
+	value interface{},
+) (T, error) {
+	// an absent value is converted to the type zero value
+	var result T
+	if value == nil {
+		return result, nil
+	}
+	// use the value if it already has the requested type
+	if typed, ok := value.(T); ok {
+		return typed, nil
+	}
+	// populate the value into the requested type
+	target := reflect.New(reflect.TypeOf(&result).Elem()).Elem()
+	populated, e := (&ConfigPartial{}).populate(value, target, true)
+	if e != nil {
+		return result, e
+	}
+	typed, ok := populated.(T)
+	if !ok {
+		return result, errConversion(value, reflect.TypeOf(&result).Elem().String())
+	}
+	return typed, nil
+}
+
+// ----------------------------------------------------------------------------
+// config
+// ----------------------------------------------------------------------------
+
+// ConfigValidator callback function used to validate a candidate
+// configuration before it replaces the current one. Any returned error
+// will reject the candidate and keep the current configuration.
+type ConfigValidator func(partial ConfigPartial) error
+
+// ConfigPrepareHook callback function called after all validators have
+// accepted a candidate configuration, so that dependent services can
+// prepare their new state before the candidate is committed and the
+// observers are notified. Any returned error will reject the candidate.
+type ConfigPrepareHook func(old, new ConfigPartial) error
+
+// ConfigAbortHook callback function called when a candidate configuration
+// prepared by the associated prepare hook has been rejected by a later
+// prepare hook, so that the dependent services can release their
+// prepared state.
+type ConfigAbortHook func(old, new ConfigPartial)
+
+type configPrepareHookRef struct {
+	prepare ConfigPrepareHook
+	abort   ConfigAbortHook
+}
+
+// ConfigErrorHandler callback function used to be called whenever a
+// background reload of the configuration has failed.
+type ConfigErrorHandler func(e error)
+
+// ConfigRevision defines a committed revision of the configuration, with
+// the id of the supplier(s) that triggered it and the list of changed
+// leaf paths, where the values of the masked keys are hidden.
+type ConfigRevision struct {
+	Revision  uint64
+	Timestamp time.Time
+	Supplier  string
+	Changes   []ConfigChange
+}
+
+// ConfigRevisionHandler callback function used to be called whenever a
+// new configuration revision is committed. As the observers, it's called
+// while holding the config lock, so it must not access the config.
+type ConfigRevisionHandler func(revision ConfigRevision)
+
+// ConfigReloadOptions defines the options of an on-demand config reload.
+// If Refresh is set, the suppliers that can re-read their content on
+// demand will also be refreshed, even if they are not observable.
+type ConfigReloadOptions struct {
+	Refresh bool
+}
+
+type configSupplierRef struct {
+	id         string
+	priority   int
+	supplier   ConfigSupplier
+	strategies []ConfigMergeStrategy
+	interval   time.Duration
+	trigger    Trigger
+}
+
+type configSupplierRefSorter []configSupplierRef
+
+func (supplier configSupplierRefSorter) Len() int {
+	return len(supplier)
+}
+
+func (supplier configSupplierRefSorter) Swap(i, j int) {
+	supplier[i], supplier[j] = supplier[j], supplier[i]
+}
+
+func (supplier configSupplierRefSorter) Less(i, j int) bool {
+	return supplier[i].priority < supplier[j].priority
+}
+
+type configObserverRef struct {
+	handle   ConfigObserverHandle
+	path     string
+	static   string
+	wildcard bool
+	current  interface{}
+	callback ConfigEventObserver
+}
+
+type configPendingError struct {
+	e        error
+	handlers []ConfigErrorHandler
+}
+
+// Config defines an object responsible to handle several config suppliers
+// and enable config content observers by path.
+type Config struct {
+	suppliers  []configSupplierRef
+	observers  []configObserverRef
+	handles    ConfigObserverHandle
+	validators []ConfigValidator
+	hooks      []configPrepareHookRef
+	handlers   []ConfigErrorHandler
+	warnings   []error
+	warners    []ConfigErrorHandler
+	pending    []configPendingError
+	mask       []string
+	revision   uint64
+	history    []ConfigRevision
+	recorders  []ConfigRevisionHandler
+	partial    *ConfigPartial
+	mutex      sync.Locker
+	observer   Trigger
+	signals    chan os.Signal
+}
+
+// NewConfig instantiate a new configuration object.
+// This object will handle a series of suppliers, alongside with the ability
+// of registration of config path/values observer callbacks that will be
+// called whenever the value has changed.
+func NewConfig() *Config {
+	// instantiate the config
+	c := &Config{
+		suppliers:  []configSupplierRef{},
+		observers:  []configObserverRef{},
+		validators: []ConfigValidator{},
+		hooks:      []configPrepareHookRef{},
+		handlers:   []ConfigErrorHandler{},
+		warnings:   []error{},
+		warners:    []ConfigErrorHandler{},
+		pending:    []configPendingError{},
+		mask:       append([]string{}, ConfigExportMask...),
+		history:    []ConfigRevision{},
+		recorders:  []ConfigRevisionHandler{},
+		partial:    &ConfigPartial{},
+		mutex:      &sync.Mutex{},
+		observer:   nil,
+	}
+	// check if there is a need to create the observable suppliers
+	// trigger
+	period := time.Duration(ConfigObserveFrequency) * time.Millisecond
+	if period != 0 {
+		// create the trigger used to poll the observable suppliers
+		// (reload failures are reported to the error handlers and
+		// must not terminate the polling trigger)
+		c.observer, _ = NewTriggerRecurring(period, func() error {
+			_ = c.reload()
+			return nil
+		})
+	}
+	// check if the config should be reloaded on the hang-up signal
+	if ConfigReloadOnSignal {
+		c.ReloadOnSignal()
+	}
+	return c
+}
+
+// Close terminates the config instance.
+// This will stop the observer trigger and call close on
+// all registered suppliers.
+func (c *Config) Close() error {
+	// close the config suppliers
+	if e := c.close(); e != nil {
+		return e
+	}
+	// stop the reload triggers and signal listening outside the lock,
+	// so any running reload can terminate
+	return c.stop()
+}
+
+func (c *Config) close() error {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// iterate through all the config suppliers checking if we can close then
+	for _, ref := range c.suppliers {
+		// check if the iterated supplier implements the closer interface
+		if source, ok := ref.supplier.(io.Closer); ok {
+			// close the supplier
+			if e := source.Close(); e != nil {
+				return e
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Config) stop() error {
+	// retrieve and clear the stored polling trigger and signal channel
+	c.mutex.Lock()
+	observer := c.observer
+	c.observer = nil
+	signals := c.signals
+	c.signals = nil
+	c.mutex.Unlock()
+	// stop listening to the process signals
+	if signals != nil {
+		signal.Stop(signals)
+		close(signals)
+	}
+	// terminate the observable suppliers polling trigger
+	if observer != nil {
+		if e := observer.Close(); e != nil {
+			return e
+		}
+	}
+	// terminate the suppliers own polling triggers
+	return c.stopSuppliers()
+}
+
+func (c *Config) stopSuppliers(
+	ids ...string,
+) error {
+	// retrieve and clear the polling triggers of the requested
+	// suppliers, or of all suppliers if no id is given
+	c.mutex.Lock()
+	var triggers []Trigger
+	for i, ref := range c.suppliers {
+		if ref.trigger == nil {
+			continue
+		}
+		requested := len(ids) == 0
+		for _, id := range ids {
+			requested = requested || id == ref.id
+		}
+		if requested {
+			triggers = append(triggers, ref.trigger)
+			c.suppliers[i].trigger = nil
+		}
+	}
+	c.mutex.Unlock()
+	// terminate the triggers outside the lock, so any running
+	// supplier reload can terminate
+	for _, trigger := range triggers {
+		if e := trigger.Close(); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// Entries will retrieve the list of stored config entries.
+func (c *Config) Entries() []string {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// retrieve the stored entries list
+	return c.partial.Entries()
+}
+
+// Has will check if a path has been loaded.
+// This means that if the values has been loaded by any registered supplier.
+func (c *Config) Has(
+	path string,
+) bool {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// check if the requested path exists in the stored partial
+	return c.partial.Has(path)
+}
+
+// Get will retrieve a configuration value loaded from a supplier.
+func (c *Config) Get(
+	path string,
+	def ...interface{},
+) (interface{}, error) {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to retrieve the requested value
+	return c.partial.Get(path, def...)
+}
+
+// Bool will retrieve a bool configuration value loaded from a supplier.
+func (c *Config) Bool(
+	path string,
+	def ...bool,
+) (bool, error) {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to retrieve a boolean value from the local partial
+	return c.partial.Bool(path, def...)
+}
+
+// Int will retrieve an integer configuration value loaded from a supplier.
+func (c *Config) Int(
+	path string,
+	def ...int,
+) (int, error) {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to retrieve an integer value from the local partial
+	return c.partial.Int(path, def...)
+}
+
+// Float will retrieve a floating point configuration value loaded
+// from a supplier.
+func (c *Config) Float(
+	path string,
+	def ...float64,
+) (float64, error) {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to retrieve a float value from the local partial
+	return c.partial.Float(path, def...)
+}
+
+// String will retrieve a string configuration value loaded from a supplier.
+func (c *Config) String(
+	path string,
+	def ...string,
+) (string, error) {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to retrieve a string value from the local partial
+	return c.partial.String(path, def...)
+}
+
+// List will retrieve a list configuration value loaded from a supplier.
+func (c *Config) List(
+	path string,
+	def ...[]interface{},
+) ([]interface{}, error) {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to retrieve a list value from the local partial
+	return c.partial.List(path, def...)
+}
+
+// Partial will retrieve partial values loaded from a supplier.
+func (c *Config) Partial(
+	path string,
+	def ...ConfigPartial,
+) (ConfigPartial, error) {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to retrieve a partial value from the local partial
+	return c.partial.Partial(path, def...)
+}
+
+// Duration will retrieve a duration configuration value loaded
+// from a supplier.
+func (c *Config) Duration(
+	path string,
+	def ...time.Duration,
+) (time.Duration, error) {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to retrieve a duration value from the local partial
+	return c.partial.Duration(path, def...)
+}
+
+// Time will retrieve a time configuration value loaded
+// from a supplier.
+func (c *Config) Time(
+	path string,
+	def ...time.Time,
+) (time.Time, error) {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to retrieve a time value from the local partial
+	return c.partial.Time(path, def...)
+}
+
+// Uint will retrieve an unsigned integer configuration value loaded
+// from a supplier.
+func (c *Config) Uint(
+	path string,
+	def ...uint,
+) (uint, error) {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to retrieve an unsigned integer value from the local partial
+	return c.partial.Uint(path, def...)
+}
+
+// Int64 will retrieve a 64 bit integer configuration value loaded
+// from a supplier.
+func (c *Config) Int64(
+	path string,
+	def ...int64,
+) (int64, error) {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to retrieve a 64 bit integer value from the local partial
+	return c.partial.Int64(path, def...)
+}
+
+// ByteSize will retrieve a byte size configuration value loaded
+// from a supplier.
+func (c *Config) ByteSize(
+	path string,
+	def ...int64,
+) (int64, error) {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to retrieve a byte size value from the local partial
+	return c.partial.ByteSize(path, def...)
+}
+
+// StringList will retrieve a string list configuration value loaded
+// from a supplier.
+func (c *Config) StringList(
+	path string,
+	def ...[]string,
+) ([]string, error) {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to retrieve a string list value from the local partial
+	return c.partial.StringList(path, def...)
+}
+
+// StringMap will retrieve a string map configuration value loaded
+// from a supplier.
+func (c *Config) StringMap(
+	path string,
+	def ...map[string]string,
+) (map[string]string, error) {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to retrieve a string map value from the local partial
+	return c.partial.StringMap(path, def...)
+}
+
+// Populate will try to populate a given value from a
+// stored configuration path.
+func (c *Config) Populate(
+	path string,
+	data interface{},
+	insensitive ...bool,
+) (interface{}, error) {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to populate a value from the local partial
+	return c.partial.Populate(path, data, insensitive...)
+}
+
+// HasSupplier check if a supplier with a specific id has been registered.
+func (c *Config) HasSupplier(
+	id string,
+) bool {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to find a supplier with the requested id
+	for _, ref := range c.suppliers {
+		if ref.id == id {
+			return true
+		}
+	}
+	return false
+}
+
+// AddSupplier register a new supplier with a specific id with a given
+// priority. Suppliers with the same priority take precedence by
+// registration order. The optional merge strategies define how the
+// supplier lists are merged with the lower priority suppliers lists.
+func (c *Config) AddSupplier(
+	id string,
+	priority int,
+	supplier ConfigSupplier,
+	strategies ...ConfigMergeStrategy,
+) error {
+	// check the supplier argument reference
+	if supplier == nil {
+		return errNilPointer("supplier")
+	}
+	// check if there is already a registered supplier with the given id
+	if c.HasSupplier(id) {
+		return errDuplicateConfigSupplier(id)
+	}
+	// lock the config for handling (reporting the queued observer
+	// errors after releasing the lock)
+	defer c.flush()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// add the supplier to the config and sort them so that the
+	// data can be correctly merged
+	previous := c.suppliers
+	c.suppliers = append(append([]configSupplierRef{}, c.suppliers...), configSupplierRef{
+		id:         id,
+		priority:   priority,
+		supplier:   supplier,
+		strategies: strategies,
+	})
+	sort.Stable(configSupplierRefSorter(c.suppliers))
+	// rebuild the local partial with the supplier's partial information
+	// and restore the previous suppliers list if the result was rejected
+	if _, e := c.rebuild(id); e != nil {
+		c.suppliers = previous
+		return e
+	}
+	return nil
+}
+
+// RemoveSupplier remove a supplier from the registration list
+// of the configuration. This will also update the configuration content and
+// re-validate the observed paths.
+func (c *Config) RemoveSupplier(
+	id string,
+) error {
+	// stop the supplier own polling trigger
+	if e := c.stopSuppliers(id); e != nil {
+		return e
+	}
+	// lock the config for handling (reporting the queued observer
+	// errors after releasing the lock)
+	defer c.flush()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to find the requested supplier to be removed
+	for i, ref := range c.suppliers {
+		if ref.id != id {
+			continue
+		}
+		// check if the supplier implements the closer interface
+		if src, ok := ref.supplier.(io.Closer); ok {
+			// close the removing supplier
+			if e := src.Close(); e != nil {
+				return e
+			}
+		}
+		// remove the supplier from the config suppliers and rebuild the
+		// local partial, restoring the previous suppliers list if the
+		// result was rejected
+		previous := c.suppliers
+		c.suppliers = append(append([]configSupplierRef{}, c.suppliers[:i]...), c.suppliers[i+1:]...)
+		if _, e := c.rebuild(id); e != nil {
+			c.suppliers = previous
+			return e
+		}
+		return nil
+	}
+	return nil
+}
+
+// RemoveAllSuppliers remove all the registered suppliers from the registration
+// list of the configuration. This will also update the configuration
+// content and re-validate the observed paths.
+func (c *Config) RemoveAllSuppliers() error {
+	// stop the suppliers own polling triggers
+	if e := c.stopSuppliers(); e != nil {
+		return e
+	}
+	// lock the config for handling (reporting the queued observer
+	// errors after releasing the lock)
+	defer c.flush()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// iterate through all the stored suppliers
+	for _, ref := range c.suppliers {
+		// check if the iterated supplier implements the close interface
+		if src, ok := ref.supplier.(io.Closer); ok {
+			// close the supplier
+			if e := src.Close(); e != nil {
+				return e
+			}
+		}
+	}
+	// recreate the suppliers array and rebuild the local partial,
+	// restoring the previous suppliers list if the result was rejected
+	previous := c.suppliers
+	c.suppliers = []configSupplierRef{}
+	if _, e := c.rebuild(""); e != nil {
+		c.suppliers = previous
+		return e
+	}
+	return nil
+}
+
+// Supplier retrieve a previously registered supplier with a requested id.
+func (c *Config) Supplier(
+	id string,
+) (ConfigSupplier, error) {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to find the requested supplier
+	for _, ref := range c.suppliers {
+		if ref.id == id {
+			return ref.supplier, nil
+		}
+	}
+	return nil, errConfigSupplierNotFound(id)
+}
+
+// SupplierPriority set a priority value of a previously registered
+// supplier with the specified id. This may change the defined values if there
+// was an override process of the configuration paths of the changing supplier.
+func (c *Config) SupplierPriority(
+	id string,
+	priority int,
+) error {
+	// lock the config for handling (reporting the queued observer
+	// errors after releasing the lock)
+	defer c.flush()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to find the requested supplier to be updated
+	for i, ref := range c.suppliers {
+		if ref.id != id {
+			continue
+		}
+		// redefine the stored supplier priority
+		previous := c.suppliers
+		c.suppliers = append([]configSupplierRef{}, c.suppliers...)
+		c.suppliers[i].priority = priority
+		// sort the suppliers and rebuild the local partial, restoring
+		// the previous suppliers list if the result was rejected
+		sort.Stable(configSupplierRefSorter(c.suppliers))
+		if _, e := c.rebuild(id); e != nil {
+			c.suppliers = previous
+			return e
+		}
+		return nil
+	}
+	return errConfigSupplierNotFound(id)
+}
+
+// SupplierInterval set the polling interval of a previously registered
+// observable supplier with the specified id. A supplier with its own
+// interval will no longer be checked by the config object polling
+// trigger, and a zero interval restores that behaviour.
+func (c *Config) SupplierInterval(
+	id string,
+	interval time.Duration,
+) error {
+	// stop the supplier current polling trigger
+	if e := c.stopSuppliers(id); e != nil {
+		return e
+	}
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// try to find the requested supplier to be updated
+	for i, ref := range c.suppliers {
+		if ref.id != id {
+			continue
+		}
+		// store the interval and create the supplier polling trigger
+		// if the supplier is an observable supplier
+		c.suppliers[i].interval = interval
+		if _, ok := ref.supplier.(ConfigObsSupplier); ok && interval > 0 {
+			c.suppliers[i].trigger, _ = NewTriggerRecurring(interval, func() error {
+				_ = c.reloadSupplier(id)
+				return nil
+			})
+		}
+		return nil
+	}
+	return errConfigSupplierNotFound(id)
+}
+
+// Explain will retrieve the provenance information of a configuration
+// path, listing the supplier that provided the resulting value and all
+// the lower priority suppliers that also defined the path.
+func (c *Config) Explain(
+	path string,
+) (*ConfigExplanation, error) {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// check if the requested path is present
+	val, e := c.partial.Get(path)
+	if e != nil {
+		return nil, e
+	}
+	return c.explain(path, val), nil
+}
+
+// ExplainAll will retrieve the provenance information of all the
+// configuration leaf paths, sorted by path.
+func (c *Config) ExplainAll() []ConfigExplanation {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// sort the stored leaf paths
+	leafs := configLeafs(*c.partial)
+	paths := make([]string, 0, len(leafs))
+	for path := range leafs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	// explain every leaf path
+	explanations := make([]ConfigExplanation, 0, len(paths))
+	for _, path := range paths {
+		explanations = append(explanations, *c.explain(path, leafs[path]))
+	}
+	return explanations
+}
+
+// ExplainTo will write the provenance information of all the
+// configuration leaf paths to the given writer, one path per line.
+func (c *Config) ExplainTo(
+	writer io.Writer,
+) error {
+	// check the writer argument reference
+	if writer == nil {
+		return errNilPointer("writer")
+	}
+	// write the explanation lines
+	for _, explanation := range c.ExplainAll() {
+		if _, e := fmt.Fprintln(writer, explanation.String()); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+func (c *Config) explain(
+	path string,
+	val interface{},
+) *ConfigExplanation {
+	// search the suppliers that define the path, from the
+	// highest priority to the lowest one
+	var origins []ConfigOrigin
+	for i := len(c.suppliers) - 1; i >= 0; i-- {
+		ref := c.suppliers[i]
+		config, e := ref.supplier.Get("")
+		if e != nil {
+			continue
+		}
+		partial, ok := config.(ConfigPartial)
+		if !ok {
+			continue
+		}
+		if value, e := partial.Get(path); e == nil {
+			origins = append(origins, ConfigOrigin{
+				Supplier: ref.id,
+				Priority: ref.priority,
+				Value:    value,
+			})
+		}
+	}
+	// compose the explanation
+	explanation := &ConfigExplanation{Path: path, Value: val}
+	if len(origins) > 0 {
+		explanation.Supplier = origins[0].Supplier
+		explanation.Priority = origins[0].Priority
+		explanation.Overridden = origins[1:]
+	}
+	// explain the leafs of a partial value, as they can be provided by
+	// different suppliers
+	if partial, ok := val.(ConfigPartial); ok {
+		c.explainLeafs(explanation, partial, origins)
+	}
+	return explanation
+}
+
+func (c *Config) explainLeafs(
+	explanation *ConfigExplanation,
+	partial ConfigPartial,
+	origins []ConfigOrigin,
+) {
+	// sort the partial leaf paths
+	leafs := configLeafs(partial)
+	if len(leafs) == 0 {
+		return
+	}
+	paths := make([]string, 0, len(leafs))
+	for path := range leafs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	// explain every leaf path
+	suppliers := map[string]bool{}
+	for _, path := range paths {
+		leafPath := path
+		if !strings.HasPrefix(path, "[") {
+			leafPath = ConfigPathSeparator + path
+		}
+		leaf := c.explain(explanation.Path+leafPath, leafs[path])
+		explanation.Leafs = append(explanation.Leafs, *leaf)
+		suppliers[leaf.Supplier] = true
+	}
+	// flag the partial as merged if the leafs come from several suppliers
+	if len(suppliers) > 1 {
+		explanation.Merged = true
+		explanation.Supplier = ""
+		explanation.Priority = 0
+		explanation.Overridden = nil
+		return
+	}
+	// report the single supplier of all the leafs
+	explanation.Supplier = explanation.Leafs[0].Supplier
+	explanation.Priority = explanation.Leafs[0].Priority
+	explanation.Overridden = nil
+	for _, origin := range origins {
+		if origin.Supplier != explanation.Supplier {
+			explanation.Overridden = append(explanation.Overridden, origin)
+		}
+	}
+}
+
+// Export will serialize the merged configuration into the requested
+// format (YAML or JSON) with a deterministic key order. If no options
+// are given, the values of the keys matching the config mask patterns
+// will be masked.
+func (c *Config) Export(
+	format string,
+	options ...ConfigExportOptions,
+) ([]byte, error) {
 	// lock the config for handling
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
-	// try to retrieve a string value from the local partial
-	return c.partial.String(path, def...)
+	// create the exporter with the requested options
+	opts := ConfigExportOptions{Mask: c.mask}
+	if len(options) > 0 {
+		opts = options[0]
+	}
+	exporter, e := newConfigExporter(opts, func(path string) *ConfigExplanation {
+		return c.explain(path, nil)
+	})
+	if e != nil {
+		return nil, e
+	}
+	// serialize the partial into the requested format
+	return exporter.export(format, *c.partial)
 }
 
-// List will retrieve a list configuration value loaded from a supplier.
-func (c *Config) List(
+// Set will store a value in the requested path of the override
+// supplier, registering it with the highest priority if not yet
+// registered. The override values are kept by the rebuilds of the
+// configuration, and a rejected change is discarded.
+func (c *Config) Set(
 	path string,
-	def ...[]interface{},
-) ([]interface{}, error) {
+	value interface{},
+) error {
+	// change the override supplier content
+	return c.override(func(source *ConfigOverrideSource) error {
+		return source.update(func(partial *ConfigPartial) error {
+			_, e := partial.Set(path, ConfigConvert(value))
+			return e
+		})
+	})
+}
+
+// Unset will remove the requested path from the override supplier.
+func (c *Config) Unset(
+	path string,
+) error {
+	// change the override supplier content
+	return c.override(func(source *ConfigOverrideSource) error {
+		return source.update(func(partial *ConfigPartial) error {
+			return partial.unset(path)
+		})
+	})
+}
+
+// PersistOverrides will define the file where the override supplier
+// content is persisted, loading the values already stored in the file.
+func (c *Config) PersistOverrides(
+	path string,
+	fileSystem afero.Fs,
+	parserFactory *ConfigParserFactory,
+) error {
+	// load the persisted content into the override supplier
+	return c.override(func(source *ConfigOverrideSource) error {
+		return source.Persist(path, fileSystem, parserFactory)
+	})
+}
+
+func (c *Config) overrides() (*ConfigOverrideSource, error) {
+	for {
+		// retrieve the registered override supplier
+		if supplier, e := c.Supplier(ConfigOverrideSupplierID); e == nil {
+			source, ok := supplier.(*ConfigOverrideSource)
+			if !ok {
+				return nil, errConversion(supplier, "*ConfigOverrideSource")
+			}
+			return source, nil
+		}
+		// register a new override supplier, retrying the retrieval if
+		// the supplier was concurrently registered
+		e := c.AddSupplier(ConfigOverrideSupplierID, ConfigOverrideSupplierPriority, NewConfigOverrideSource())
+		if e != nil && !errors.Is(e, ErrDuplicateConfigSupplier) {
+			return nil, e
+		}
+	}
+}
+
+func (c *Config) override(
+	change func(source *ConfigOverrideSource) error,
+) error {
+	// retrieve the override supplier
+	source, e := c.overrides()
+	if e != nil {
+		return e
+	}
+	// lock the config for handling (reporting the queued observer
+	// errors after releasing the lock)
+	defer c.flush()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// change the supplier content and rebuild the local partial,
+	// restoring the previous content if the result was rejected
+	previous := source.snapshot()
+	if e := change(source); e != nil {
+		return e
+	}
+	if _, e := c.rebuild(ConfigOverrideSupplierID); e != nil {
+		source.restore(previous)
+		return e
+	}
+	// persist the committed override content
+	return source.Save()
+}
+
+// HasObserver check if there is an observer to a configuration value path.
+func (c *Config) HasObserver(
+	path string,
+) bool {
 	// lock the config for handling
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
-	// try to retrieve a list value from the local partial
-	return c.partial.List(path, def...)
+	// check if the requested observer is registered
+	path = configKey(path)
+	for _, oreg := range c.observers {
+		if configKeyEqual(oreg.path, path) {
+			return true
+		}
+	}
+	return false
 }
 
-// Partial will retrieve partial values loaded from a supplier.
-func (c *Config) Partial(
+// AddObserver register a new observer to a configuration path.
+// The returned handle can be used to remove this specific registration.
+func (c *Config) AddObserver(
 	path string,
-	def ...ConfigPartial,
-) (ConfigPartial, error) {
+	callback ConfigObserver,
+) (ConfigObserverHandle, error) {
+	// validate the callback argument reference
+	if callback == nil {
+		return 0, errNilPointer("callback")
+	}
+	// register the callback as an event observer
+	return c.Observe(path, func(event ConfigEvent) {
+		callback(event.Old, event.New)
+	})
+}
+
+// Observe register a new event observer to a configuration path.
+// The path can contain wildcard parts, where a single star (*) matches
+// any path part and a double star (**) matches any number of path parts.
+// The returned handle can be used to remove this specific registration.
+func (c *Config) Observe(
+	path string,
+	callback ConfigEventObserver,
+) (ConfigObserverHandle, error) {
+	// validate the callback argument reference
+	if callback == nil {
+		return 0, errNilPointer("callback")
+	}
 	// lock the config for handling
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
-	// try to retrieve a partial value from the local partial
-	return c.partial.Partial(path, def...)
+	// check if the requested path (or the wildcard path prefix) is present
+	path = configKey(path)
+	static, _ := configPathStatic(path)
+	if _, e := c.partial.Get(static); e != nil {
+		return 0, e
+	}
+	// register the requested observer
+	return c.observe(path, callback), nil
 }
 
-// Populate will try to populate a given value from a
-// stored configuration path.
-func (c *Config) Populate(
+func (c *Config) observe(
 	path string,
-	data interface{},
-	insensitive ...bool,
-) (interface{}, error) {
+	callback ConfigEventObserver,
+) ConfigObserverHandle {
+	// retrieve the current value of the observed path (or the wildcard
+	// path prefix), cloning a partial, so it can be used for update checks
+	static, wildcard := configPathStatic(path)
+	val, _ := c.partial.Get(static)
+	if v, ok := val.(ConfigPartial); ok {
+		val = v.Clone()
+	}
+	// register the requested observer with the current path value
+	c.handles++
+	c.observers = append(c.observers, configObserverRef{
+		handle:   c.handles,
+		path:     path,
+		static:   static,
+		wildcard: wildcard,
+		current:  val,
+		callback: callback,
+	})
+	return c.handles
+}
+
+// RemoveObserver remove all the observers registered to a
+// configuration path.
+func (c *Config) RemoveObserver(
+	path string,
+) {
 	// lock the config for handling
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
-	// try to populate a value from the local partial
-	return c.partial.Populate(path, data, insensitive...)
+	// filter the observers registered to the requested path
+	path = configKey(path)
+	var observers []configObserverRef
+	for _, observer := range c.observers {
+		if !configKeyEqual(observer.path, path) {
+			observers = append(observers, observer)
+		}
+	}
+	c.observers = observers
 }
 
-// HasSupplier check if a supplier with a specific id has been registered.
-func (c *Config) HasSupplier(
-	id string,
-) bool {
+// RemoveObserverHandle remove the observer registration identified
+// by the given handle.
+func (c *Config) RemoveObserverHandle(
+	handle ConfigObserverHandle,
+) {
 	// lock the config for handling
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
-	// try to find a supplier with the requested id
-	for _, ref := range c.suppliers {
-		if ref.id == id {
-			return true
+	// try to find the observer to be removed
+	for i, observer := range c.observers {
+		if observer.handle == handle {
+			// remove the found observer
+			c.observers = append(c.observers[:i], c.observers[i+1:]...)
+			return
 		}
 	}
-	return false
 }
 
-// AddSupplier register a new supplier with a specific id with a given priority.
-func (c *Config) AddSupplier(
-	id string,
-	priority int,
-	supplier ConfigSupplier,
+// Bind will populate the data argument, that must be a pointer, with
+// the content stored in the requested path and return a binding that
+// holds a copy of the populated value, refreshed whenever the path
+// content changes. The data argument content is used as the default
+// value of every refresh, and the binding callbacks are called while
+// the configuration is locked, so they must not access it.
+func (c *Config) Bind(
+	path string,
+	data interface{},
+	options ...ConfigBindOptions,
+) (*ConfigBinding, error) {
+	// check the data argument reference
+	if data == nil {
+		return nil, errNilPointer("data")
+	}
+	target := reflect.ValueOf(data)
+	if target.Kind() != reflect.Ptr || target.IsNil() {
+		return nil, errConversion(data, "pointer")
+	}
+	// create the binding with a copy of the default value
+	binding := &ConfigBinding{
+		config:   c,
+		defaults: reflect.New(target.Elem().Type()).Elem(),
+	}
+	binding.defaults.Set(target.Elem())
+	if len(options) > 0 {
+		binding.options = options[0]
+	}
+	// lock the config for handling, so no change is lost between
+	// the initial population and the observer registration
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// populate the initial bound value
+	path = configKey(path)
+	val, e := c.partial.Get(path)
+	if e != nil {
+		return nil, e
+	}
+	value, e := binding.populate(val)
+	if e != nil {
+		return nil, e
+	}
+	target.Elem().Set(reflect.ValueOf(value))
+	binding.value.Store(value)
+	// register the binding update observer
+	binding.handle = c.observe(path, binding.update)
+	return binding, nil
+}
+
+// AddValidator register a validator that will be called with every
+// candidate configuration before it replaces the current one. A rejected
+// candidate keeps the current configuration and the observers untouched.
+func (c *Config) AddValidator(
+	validator ConfigValidator,
 ) error {
-	// check the supplier argument reference
-	if supplier == nil {
-		return errNilPointer("supplier")
+	// check the validator argument reference
+	if validator == nil {
+		return errNilPointer("validator")
 	}
-	// check if there is already a registered supplier with the given id
-	if c.HasSupplier(id) {
-		return errDuplicateConfigSupplier(id)
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// store the validator
+	c.validators = append(c.validators, validator)
+	return nil
+}
+
+// AddPrepareHook register a hook that will be called with the current and
+// the candidate configuration after the candidate has been validated, and
+// before it's committed and the observers are notified. The optional
+// abort hook is called if the hook has successfully prepared a candidate
+// that is then rejected by a later prepare hook.
+func (c *Config) AddPrepareHook(
+	hook ConfigPrepareHook,
+	abort ...ConfigAbortHook,
+) error {
+	// check the hook argument reference
+	if hook == nil {
+		return errNilPointer("hook")
+	}
+	ref := configPrepareHookRef{prepare: hook}
+	if len(abort) > 0 {
+		ref.abort = abort[0]
 	}
 	// lock the config for handling
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
-	// add the supplier to the config and sort them so that the
-	// data can be correctly merged
-	c.suppliers = append(c.suppliers, configSupplierRef{id, priority, supplier})
-	sort.Sort(configSupplierRefSorter(c.suppliers))
-	// rebuild the local partial with the supplier's partial information
-	c.rebuild()
+	// store the prepare hook
+	c.hooks = append(c.hooks, ref)
 	return nil
 }
 
-// RemoveSupplier remove a supplier from the registration list
-// of the configuration. This will also update the configuration content and
-// re-validate the observed paths.
-func (c *Config) RemoveSupplier(
-	id string,
+// AddErrorHandler register a callback that will be called with every
+// error raised by a background reload of the observable suppliers,
+// including the rejection of the reloaded configuration.
+func (c *Config) AddErrorHandler(
+	handler ConfigErrorHandler,
 ) error {
+	// check the handler argument reference
+	if handler == nil {
+		return errNilPointer("handler")
+	}
 	// lock the config for handling
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
-	// try to find the requested supplier to be removed
-	for i, ref := range c.suppliers {
-		if ref.id != id {
+	// store the error handler
+	c.handlers = append(c.handlers, handler)
+	return nil
+}
+
+// AddWarningHandler register a callback that will be called with every
+// recorded and future config warning, like the unavailable optional
+// suppliers skipped by the loader.
+func (c *Config) AddWarningHandler(
+	handler ConfigErrorHandler,
+) error {
+	// check the handler argument reference
+	if handler == nil {
+		return errNilPointer("handler")
+	}
+	// store the warning handler and retrieve the recorded warnings
+	c.mutex.Lock()
+	c.warners = append(c.warners, handler)
+	warnings := make([]error, len(c.warnings))
+	copy(warnings, c.warnings)
+	c.mutex.Unlock()
+	// report the recorded warnings to the new handler
+	for _, e := range warnings {
+		handler(e)
+	}
+	return nil
+}
+
+// Warnings retrieves the list of recorded config warnings.
+func (c *Config) Warnings() []error {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// return a copy of the recorded warnings
+	warnings := make([]error, len(c.warnings))
+	copy(warnings, c.warnings)
+	return warnings
+}
+
+// SetExportMask will store the key patterns of the values masked by the
+// exports without options, and by the changes kept in the revisions
+// history and retrieved by Diff. The default patterns are the ones
+// defined by ConfigExportMask.
+func (c *Config) SetExportMask(
+	patterns []string,
+) error {
+	// validate the mask patterns
+	if _, e := newConfigExporter(ConfigExportOptions{Mask: patterns}, nil); e != nil {
+		return e
+	}
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// store the mask patterns
+	c.mask = append([]string{}, patterns...)
+	return nil
+}
+
+// AddRevisionHandler register a callback that will be called with every
+// committed configuration revision.
+func (c *Config) AddRevisionHandler(
+	handler ConfigRevisionHandler,
+) error {
+	// check the handler argument reference
+	if handler == nil {
+		return errNilPointer("handler")
+	}
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// store the revision handler
+	c.recorders = append(c.recorders, handler)
+	return nil
+}
+
+// Revision retrieves the number of the last committed configuration
+// revision.
+func (c *Config) Revision() uint64 {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// return the current revision number
+	return c.revision
+}
+
+// History retrieves the list of the committed configuration revisions
+// kept in the bounded config history, from the oldest to the newest.
+func (c *Config) History() []ConfigRevision {
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// return a copy of the stored history
+	history := make([]ConfigRevision, len(c.history))
+	copy(history, c.history)
+	return history
+}
+
+// Diff retrieves the list of changed leaf paths between two configuration
+// revisions, composed from the revisions kept in the config history.
+// If the first revision is newer than the second, the changes are
+// reverted (the old values are the ones of the first revision).
+func (c *Config) Diff(
+	from,
+	to uint64,
+) ([]ConfigChange, error) {
+	// check if the revisions are reversed
+	if from > to {
+		changes, e := c.Diff(to, from)
+		for i, change := range changes {
+			changes[i].Old, changes[i].New = change.New, change.Old
+		}
+		return changes, e
+	}
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// check if the revisions can be composed from the stored history
+	oldest := c.revision
+	if len(c.history) != 0 {
+		oldest = c.history[0].Revision - 1
+	}
+	if from < oldest {
+		return nil, errConfigRevisionNotFound(from)
+	}
+	if to > c.revision {
+		return nil, errConfigRevisionNotFound(to)
+	}
+	// compose the changes of the revisions between the requested ones
+	composed := map[string]*ConfigChange{}
+	for _, revision := range c.history {
+		if revision.Revision <= from || revision.Revision > to {
 			continue
 		}
-		// check if the supplier implements the closer interface
-		if src, ok := ref.supplier.(io.Closer); ok {
-			// close the removing supplier
-			if e := src.Close(); e != nil {
-				return e
+		for _, change := range revision.Changes {
+			if current, ok := composed[change.Path]; ok {
+				current.New = change.New
+				continue
 			}
+			composed[change.Path] = &ConfigChange{Path: change.Path, Old: change.Old, New: change.New}
 		}
-		// remove the supplier from the config suppliers
-		c.suppliers = append(c.suppliers[:i], c.suppliers[i+1:]...)
-		// rebuild the local partial
-		c.rebuild()
-		return nil
 	}
-	return nil
+	// discard the paths restored to its original value, and sort
+	// the resulting changes
+	exporter := c.masker()
+	changes := []ConfigChange{}
+	for path, change := range composed {
+		masked := exporter != nil && configMaskedPath(exporter, path)
+		if reflect.DeepEqual(change.Old, change.New) && !masked {
+			continue
+		}
+		changes = append(changes, *change)
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes, nil
+}
+
+// Reload will force all the observable suppliers to check for updates,
+// rebuilding the configuration if any of them has changed, and return the
+// list of changed leaf paths. If requested by the options, the suppliers
+// that can be refreshed on demand will also re-read their content.
+func (c *Config) Reload(
+	ctx context.Context,
+	options ...ConfigReloadOptions,
+) ([]ConfigChange, error) {
+	// check the context argument reference
+	if ctx == nil {
+		return nil, errNilPointer("ctx")
+	}
+	// parse the reload options
+	opts := ConfigReloadOptions{}
+	if len(options) > 0 {
+		opts = options[0]
+	}
+	// reload all the registered suppliers
+	changes, failures := c.reloadSuppliers(ctx, c.supplierRefs(true), opts.Refresh)
+	if len(failures) != 0 {
+		return changes, failures[0]
+	}
+	return changes, nil
+}
+
+// ReloadOnSignal will bind the reload of all the registered suppliers,
+// including the refresh of the non-observable ones, to the reception of
+// the given process signals (the hang-up signal if none is given).
+// Reload failures are reported to the registered error handlers.
+func (c *Config) ReloadOnSignal(
+	signals ...os.Signal,
+) {
+	// default to the hang-up signal
+	if len(signals) == 0 {
+		signals = []os.Signal{syscall.SIGHUP}
+	}
+	// lock the config for handling
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// create the signal channel and listener if not created yet
+	if c.signals == nil {
+		c.signals = make(chan os.Signal, 1)
+		go func(signals chan os.Signal) {
+			for range signals {
+				_ = c.report(c.reloadSuppliers(context.Background(), c.supplierRefs(true), true))
+			}
+		}(c.signals)
+	}
+	signal.Notify(c.signals, signals...)
 }
 
-// RemoveAllSuppliers remove all the registered suppliers from the registration
-// list of the configuration. This will also update the configuration
-// content and re-validate the observed paths.
-func (c *Config) RemoveAllSuppliers() error {
+func (c *Config) supplierRefs(
+	all bool,
+) []configSupplierRef {
 	// lock the config for handling
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
-	// iterate through all the stored suppliers
+	// retrieve the suppliers, or only the ones polled by the
+	// config polling trigger
+	var refs []configSupplierRef
 	for _, ref := range c.suppliers {
-		// check if the iterated supplier implements the close interface
-		if src, ok := ref.supplier.(io.Closer); ok {
-			// close the supplier
-			if e := src.Close(); e != nil {
-				return e
+		if all || ref.interval <= 0 {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+func (c *Config) reload() error {
+	// reload the suppliers polled by the config polling trigger
+	return c.report(c.reloadSuppliers(context.Background(), c.supplierRefs(false), false))
+}
+
+func (c *Config) reloadSupplier(
+	id string,
+) error {
+	// reload the requested supplier
+	for _, ref := range c.supplierRefs(true) {
+		if ref.id == id {
+			return c.report(c.reloadSuppliers(context.Background(), []configSupplierRef{ref}, false))
+		}
+	}
+	return nil
+}
+
+func (c *Config) reloadSuppliers(
+	ctx context.Context,
+	refs []configSupplierRef,
+	refresh bool,
+) ([]ConfigChange, []error) {
+	// iterate through all the given suppliers
+	var failures []error
+	var reloaded []string
+	for _, ref := range refs {
+		// check if the reload has been canceled before reloading the
+		// supplier (the already reloaded suppliers are still rebuilt, as
+		// their content has been updated)
+		if e := ctx.Err(); e != nil {
+			failures = append(failures, e)
+			break
+		}
+		// check if the supplier should be refreshed on demand
+		if supplier, ok := ref.supplier.(ConfigRefreshSupplier); ok && refresh {
+			if e := supplier.Refresh(); e != nil {
+				failures = append(failures, e)
+				continue
+			}
+			reloaded = append(reloaded, ref.id)
+			continue
+		}
+		// check if the iterated supplier is an observable supplier
+		if supplier, ok := ref.supplier.(ConfigObsSupplier); ok {
+			// reload the supplier and update the reloaded flag if the request
+			// resulted in a supplier info update (even if failing, as the
+			// supplier may report a partial update)
+			updated, e := supplier.Reload()
+			if e != nil {
+				failures = append(failures, e)
+			}
+			if updated {
+				reloaded = append(reloaded, ref.id)
 			}
 		}
 	}
-	// recreate the suppliers array and rebuild the local partial
-	c.suppliers = []configSupplierRef{}
-	c.rebuild()
-	return nil
-}
-
-// Supplier retrieve a previously registered supplier with a requested id.
-func (c *Config) Supplier(
-	id string,
-) (ConfigSupplier, error) {
-	// lock the config for handling
+	// check if the iteration resulted in an update of any info
+	if len(reloaded) == 0 {
+		return nil, failures
+	}
+	// lock the config for handling (reporting the queued observer
+	// errors after releasing the lock)
+	defer c.flush()
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
-	// try to find the requested supplier
-	for _, ref := range c.suppliers {
-		if ref.id == id {
-			return ref.supplier, nil
-		}
+	// rebuild the local partial with the new supplier info
+	changes, e := c.rebuild(strings.Join(reloaded, ","))
+	if e != nil {
+		failures = append(failures, e)
 	}
-	return nil, errConfigSupplierNotFound(id)
+	return changes, failures
 }
 
-// SupplierPriority set a priority value of a previously registered
-// supplier with the specified id. This may change the defined values if there
-// was an override process of the configuration paths of the changing supplier.
-func (c *Config) SupplierPriority(
-	id string,
-	priority int,
+func (c *Config) report(
+	_ []ConfigChange,
+	failures []error,
 ) error {
-	// lock the config for handling
+	// check if there is any failure to be reported
+	if len(failures) == 0 {
+		return nil
+	}
+	// report the reload failures to the registered error handlers
+	// (outside the lock, so handlers can access the config)
 	c.mutex.Lock()
-	defer c.mutex.Unlock()
-	// try to find the requested supplier to be updated
-	for i, ref := range c.suppliers {
-		if ref.id != id {
-			continue
+	handlers := c.handlers
+	c.mutex.Unlock()
+	for _, e := range failures {
+		for _, handler := range handlers {
+			handler(e)
 		}
-		// redefine the stored supplier priority
-		c.suppliers[i] = configSupplierRef{
-			id:       ref.id,
-			priority: priority,
-			supplier: ref.supplier,
-		}
-		// sort the suppliers and rebuild the local partial
-		sort.Sort(configSupplierRefSorter(c.suppliers))
-		c.rebuild()
-		return nil
 	}
-	return errConfigSupplierNotFound(id)
+	return failures[0]
 }
 
-// HasObserver check if there is an observer to a configuration value path.
-func (c *Config) HasObserver(
-	path string,
-) bool {
-	// lock the config for handling
+func (c *Config) warn(
+	e error,
+) {
+	// record the warning
 	c.mutex.Lock()
-	defer c.mutex.Unlock()
-	// check if the requested observer is registered
-	for _, oreg := range c.observers {
-		if oreg.path == path {
-			return true
-		}
+	handlers := c.recordWarning(e)
+	c.mutex.Unlock()
+	// report the warning to the registered warning handlers
+	// (outside the lock, so handlers can access the config)
+	for _, handler := range handlers {
+		handler(e)
 	}
-	return false
 }
 
-// AddObserver register a new observer to a configuration path.
-func (c *Config) AddObserver(
-	path string,
-	callback ConfigObserver,
-) error {
-	// validate the callback argument reference
-	if callback == nil {
-		return errNilPointer("callback")
-	}
-	// check if the requested path is present
-	val, e := c.Get(path)
-	if e != nil {
-		return e
+func (c *Config) masker() *configExporter {
+	// create the exporter used to mask the changes values
+	// (the config lock must be held by the caller)
+	exporter, _ := newConfigExporter(ConfigExportOptions{Mask: c.mask}, nil)
+	return exporter
+}
+
+func (c *Config) recordWarning(
+	e error,
+) []ConfigErrorHandler {
+	// store the warning (the config lock must be held by the caller)
+	// and return the handlers to be notified
+	c.warnings = append(c.warnings, e)
+	return c.warners
+}
+
+func (c *Config) queue(
+	e error,
+	handler ConfigErrorHandler,
+) {
+	// queue the error with the handlers to be notified (the config lock
+	// must be held by the caller), recording it as a warning if there
+	// is no error handler to report it to
+	handlers := c.handlers
+	if handler != nil {
+		handlers = []ConfigErrorHandler{handler}
 	}
-	// if the founded value is a partial, clone it, so
-	// it can be used for update checks
-	if v, ok := val.(ConfigPartial); ok {
-		val = v.Clone()
+	if len(handlers) == 0 {
+		handlers = c.recordWarning(e)
 	}
-	// lock the config for handling
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
-	// register the requested observer with the current path value
-	c.observers = append(c.observers, configObserverRef{path, val, callback})
-	return nil
+	c.pending = append(c.pending, configPendingError{e: e, handlers: handlers})
 }
 
-// RemoveObserver remove an observer to a configuration path.
-func (c *Config) RemoveObserver(
-	path string,
-) {
-	// lock the config for handling
+func (c *Config) flush() {
+	// retrieve and clear the queued errors
 	c.mutex.Lock()
-	defer c.mutex.Unlock()
-	// try to find the observer to be removed
-	for i, observer := range c.observers {
-		if observer.path == path {
-			// remove the found observer
-			c.observers = append(c.observers[:i], c.observers[i+1:]...)
-			return
+	pending := c.pending
+	c.pending = []configPendingError{}
+	c.mutex.Unlock()
+	// report the queued errors (outside the lock, so handlers can
+	// access the config)
+	for _, p := range pending {
+		for _, handler := range p.handlers {
+			handler(p.e)
 		}
 	}
 }
 
-func (c *Config) reload() error {
-	// iterate through all stores suppliers
-	reloaded := false
-	for _, ref := range c.suppliers {
-		// check if the iterated supplier is an observable supplier
-		if supplier, ok := ref.supplier.(ConfigObsSupplier); ok {
-			// reload the supplier and update the reloaded flag if the request
-			// resulted in a supplier info update
-			updated, _ := supplier.Reload()
-			reloaded = reloaded || updated
+func (c *Config) record(
+	trigger string,
+	changes []ConfigChange,
+) {
+	// compose the revision with the masked changes
+	c.revision++
+	revision := ConfigRevision{
+		Revision:  c.revision,
+		Timestamp: time.Now(),
+		Supplier:  trigger,
+		Changes:   configMaskChanges(c.masker(), changes),
+	}
+	// store the revision in the bounded history
+	if ConfigHistorySize > 0 {
+		c.history = append(c.history, revision)
+		if len(c.history) > ConfigHistorySize {
+			c.history = append([]ConfigRevision{}, c.history[len(c.history)-ConfigHistorySize:]...)
 		}
 	}
-	// check if the iteration resulted in an update of any info
-	if reloaded {
-		// lock the config for handling
-		c.mutex.Lock()
-		defer c.mutex.Unlock()
-		// rebuild the local partial with the new supplier info
-		c.rebuild()
+	// notify the revision handlers
+	for _, handler := range c.recorders {
+		handler(revision)
 	}
-	return nil
 }
 
-func (c *Config) rebuild() {
+func (c *Config) rebuild(
+	trigger string,
+) ([]ConfigChange, error) {
 	// iterate through all the stored suppliers
 	updated := ConfigPartial{}
 	for _, ref := range c.suppliers {
 		// retrieve the supplier stored partial information
-		// and Merge it with all parsed suppliers
+		// and Merge a copy of it with all parsed suppliers, so the
+		// supplier information is not changed by the merging process
 		config, _ := ref.supplier.Get("")
-		updated.Merge(config.(ConfigPartial))
+		partial := config.(ConfigPartial)
+		updated.Merge(partial.Clone(), ref.strategies...)
+	}
+	// validate the candidate partial
+	for _, validator := range c.validators {
+		if e := validator(updated); e != nil {
+			return nil, e
+		}
+	}
+	// call the prepare hooks with the current and candidate partials,
+	// aborting the already prepared ones if the candidate is rejected
+	for i, hook := range c.hooks {
+		if e := hook.prepare(*c.partial, updated); e != nil {
+			for j := i - 1; j >= 0; j-- {
+				if c.hooks[j].abort != nil {
+					c.hooks[j].abort(*c.partial, updated)
+				}
+			}
+			return nil, e
+		}
 	}
-	// store locally the resulting partial
+	// commit locally the resulting partial
+	previous := c.partial
 	c.partial = &updated
+	// record the committed revision
+	changes := configDiff(*previous, updated)
+	if len(changes) != 0 {
+		c.record(trigger, changes)
+	}
 	// iterate through all observers
 	for id, observer := range c.observers {
+		// filter the changes that affect the observer path
+		var matched []ConfigChange
+		for _, change := range changes {
+			if configPathMatch(observer.path, change.Path) {
+				matched = append(matched, change)
+			}
+		}
 		// retrieve the observer path value
 		// and check if the current value differs from the previous one
-		val, e := c.partial.Get(observer.path)
-		if e == nil && !reflect.DeepEqual(observer.current, val) {
-			// store the new value in the observer registry
-			// and call the observer callback
-			old := observer.current
-			c.observers[id].current = val
-			observer.callback(old, val)
+		val, e := c.partial.Get(observer.static)
+		if observer.wildcard {
+			if len(matched) == 0 {
+				continue
+			}
+		} else if e != nil || reflect.DeepEqual(observer.current, val) {
+			continue
 		}
+		// store the new value in the observer registry
+		// and call the observer callback
+		old := observer.current
+		c.observers[id].current = val
+		observer.callback(ConfigEvent{
+			Path:    observer.path,
+			Old:     old,
+			New:     val,
+			Changes: matched,
+		})
 	}
+	return changes, nil
 }
 
 // ----------------------------------------------------------------------------
 // config loader
 // ----------------------------------------------------------------------------
 
+// ConfigLoaderInterface defines the interface of the service responsible to
+// initialize the configuration manager on the config services boot.
+type ConfigLoaderInterface interface {
+	Load() error
+}
+
 // ConfigLoader defines an object responsible to initialize a
 // configuration manager.
 type ConfigLoader struct {
 	config          *Config
 	supplierFactory *ConfigSupplierFactory
+	fileSystem      afero.Fs
 }
 
+var _ ConfigLoaderInterface = &ConfigLoader{}
+
 // NewConfigLoader instantiate a new configuration loader instance.
+// The optional file system is used to store the suppliers cache copies.
 func NewConfigLoader(
 	config *Config,
 	supplierFactory *ConfigSupplierFactory,
+	fileSystem ...afero.Fs,
 ) (*ConfigLoader, error) {
 	// check config manager argument reference
 	if config == nil {
@@ -2802,34 +7647,43 @@ func NewConfigLoader(
 		return nil, errNilPointer("supplierFactory")
 	}
 	// instantiate the loader
-	return &ConfigLoader{
+	loader := &ConfigLoader{
 		config:          config,
 		supplierFactory: supplierFactory,
-	}, nil
+	}
+	if len(fileSystem) > 0 {
+		loader.fileSystem = fileSystem[0]
+	}
+	return loader, nil
 }
 
 // Load loads the configuration from a well-defined file.
 func (l ConfigLoader) Load() error {
-	// retrieve the loader entry file partial content
-	supplier, e := l.supplierFactory.Create(&ConfigPartial{
-		"type":   "file",
-		"path":   ConfigLoaderFileSupplierPath,
-		"format": ConfigLoaderSupplierFormat,
-	})
+	// retrieve the loader entry suppliers definitions
+	entries, e := l.entries()
 	if e != nil {
 		return e
 	}
-	// add the loaded entry file content into the manager
-	if e := l.config.AddSupplier(ConfigLoaderSupplierID, 0, supplier); e != nil {
-		return e
+	// add the loader entry suppliers content into the manager
+	for i, entry := range entries {
+		id := ConfigLoaderSupplierID
+		if i > 0 {
+			id = fmt.Sprintf("%s.%d", ConfigLoaderSupplierID, i)
+		}
+		if e := l.loadEntry(id, i*(len(ConfigProfiles)+1), entry); e != nil {
+			return e
+		}
 	}
 	// retrieve from the loaded info the partial entries list
 	suppliers, e := l.config.Partial(ConfigLoaderSupplierListPath)
 	if e != nil {
 		return nil
 	}
-	// iterate through the suppliers list
-	for _, id := range suppliers.Entries() {
+	// iterate through the suppliers list (sorted by id, so suppliers
+	// with the same priority are always registered in the same order)
+	ids := suppliers.Entries()
+	sort.Strings(ids)
+	for _, id := range ids {
 		// retrieve the source list entry
 		if partial, e := suppliers.Partial(id); e == nil {
 			// load the source
@@ -2841,22 +7695,274 @@ func (l ConfigLoader) Load() error {
 	return nil
 }
 
+func (l ConfigLoader) entries() ([]ConfigPartial, error) {
+	// use the loader entry file if no entry suppliers list was given
+	if ConfigLoaderEntries == "" {
+		return []ConfigPartial{{
+			"type":   ConfigTypeFile,
+			"path":   ConfigLoaderFileSupplierPath,
+			"format": ConfigLoaderSupplierFormat,
+		}}, nil
+	}
+	// parse the entry suppliers definitions list
+	var data []interface{}
+	if e := json.Unmarshal([]byte(ConfigLoaderEntries), &data); e != nil {
+		return nil, e
+	}
+	var entries []ConfigPartial
+	for _, entry := range data {
+		partial, ok := ConfigConvert(entry).(ConfigPartial)
+		if !ok {
+			return nil, errConversion(entry, "ConfigPartial")
+		}
+		entries = append(entries, partial)
+	}
+	return entries, nil
+}
+
+func (l ConfigLoader) loadEntry(
+	id string,
+	priority int,
+	config ConfigPartial,
+) error {
+	// create the loader entry supplier
+	supplier, e := l.supplierFactory.Create(&config)
+	if e != nil {
+		return e
+	}
+	// add the loaded entry supplier content into the manager
+	if e := l.config.AddSupplier(id, priority, supplier); e != nil {
+		return e
+	}
+	// add the active profiles entry overlays content into the manager
+	for i, profile := range ConfigProfiles {
+		supplier, e := l.loadProfile(config, profile)
+		if e != nil {
+			return e
+		}
+		if supplier == nil {
+			continue
+		}
+		if e := l.config.AddSupplier(id+"."+profile, priority+i+1, supplier); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
 func (l ConfigLoader) loadSupplier(
 	id string,
 	config ConfigPartial,
 ) error {
 	// parse the configuration
-	sConfig := struct{ Priority int }{}
+	sConfig := struct {
+		Priority int
+		Interval int
+		Merge    []interface{}
+		Profiles []interface{}
+		Optional bool
+		Required bool
+		Fallback interface{}
+		Case     string
+	}{Required: true}
 	if _, e := config.Populate("", &sConfig); e != nil {
 		return e
 	}
-	// create the requested config supplier
-	supplier, e := l.supplierFactory.Create(&config)
+	cachePath, e := config.String("cache_path", "")
+	if e != nil {
+		return e
+	}
+	// check if the supplier is restricted to inactive profiles
+	if !configProfileActive(sConfig.Profiles) {
+		return nil
+	}
+	// parse the supplier list merge strategies
+	strategies, e := NewConfigMergeStrategies(sConfig.Merge)
 	if e != nil {
 		return e
 	}
+	// create the requested config supplier, skipping unavailable
+	// optional suppliers
+	supplier, e := l.createSupplier(id, config, cachePath, sConfig.Fallback)
+	if e != nil {
+		if !sConfig.Optional && sConfig.Required {
+			return e
+		}
+		l.config.warn(errConfigSupplierUnavailable(id, map[string]interface{}{
+			"action": "skipped",
+			"error":  e.Error(),
+		}))
+		return nil
+	}
+	// apply the supplier own keys case policy if defined
+	if sConfig.Case != "" {
+		if supplier, e = NewConfigCaseSource(supplier, sConfig.Case); e != nil {
+			return e
+		}
+	}
 	// add the loaded supplier to the config manager
-	return l.config.AddSupplier(id, sConfig.Priority, supplier)
+	if e := l.addSupplier(id, sConfig.Priority, sConfig.Interval, supplier, strategies); e != nil {
+		return e
+	}
+	// add the active profiles overlays of the supplier
+	for i, profile := range ConfigProfiles {
+		overlay, e := l.loadProfile(config, profile)
+		if e != nil {
+			return e
+		}
+		if overlay == nil {
+			continue
+		}
+		if sConfig.Case != "" {
+			if overlay, e = NewConfigCaseSource(overlay, sConfig.Case); e != nil {
+				return e
+			}
+		}
+		if e := l.addSupplier(id+"."+profile, sConfig.Priority+i+1, sConfig.Interval, overlay, strategies); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+func (l ConfigLoader) createSupplier(
+	id string,
+	config ConfigPartial,
+	cachePath string,
+	fallback interface{},
+) (ConfigSupplier, error) {
+	// check the file system used to store the supplier cache copy
+	if cachePath != "" && l.fileSystem == nil {
+		return nil, errNilPointer("fileSystem")
+	}
+	// create the requested config supplier, keeping a
+	// last-known-good copy of its content if requested
+	supplier, failure := l.supplierFactory.Create(&config)
+	if failure == nil {
+		if cachePath == "" {
+			return supplier, nil
+		}
+		return NewConfigCacheSource(supplier, cachePath, l.fileSystem)
+	}
+	// try to use the last-known-good copy of the supplier content
+	if cachePath != "" {
+		if exists, _ := afero.Exists(l.fileSystem, cachePath); exists {
+			cached, e := l.supplierFactory.Create(&ConfigPartial{
+				"type":   ConfigTypeFile,
+				"path":   cachePath,
+				"format": configFormatFromPath(cachePath, ConfigFormatYAML),
+			})
+			if e == nil {
+				l.config.warn(errConfigSupplierUnavailable(id, map[string]interface{}{
+					"action": "cache",
+					"error":  failure.Error(),
+				}))
+				return cached, nil
+			}
+		}
+	}
+	// try to use the fallback supplier, given by its definition or
+	// by the id of other loader supplier entry
+	if fallback == nil {
+		return nil, failure
+	}
+	var definition ConfigPartial
+	switch entry := fallback.(type) {
+	case ConfigPartial:
+		definition = entry
+	case string:
+		partial, e := l.config.Partial(ConfigLoaderSupplierListPath + ConfigPathSeparator + entry)
+		if e != nil {
+			return nil, failure
+		}
+		definition = partial
+	default:
+		return nil, errConversion(fallback, "ConfigPartial")
+	}
+	supplier, e := l.supplierFactory.Create(&definition)
+	if e != nil {
+		return nil, failure
+	}
+	l.config.warn(errConfigSupplierUnavailable(id, map[string]interface{}{
+		"action": "fallback",
+		"error":  failure.Error(),
+	}))
+	return supplier, nil
+}
+
+func (l ConfigLoader) addSupplier(
+	id string,
+	priority int,
+	interval int,
+	supplier ConfigSupplier,
+	strategies []ConfigMergeStrategy,
+) error {
+	// add the supplier to the config manager
+	if e := l.config.AddSupplier(id, priority, supplier, strategies...); e != nil {
+		return e
+	}
+	// set the supplier own polling interval (in milliseconds) if defined
+	if interval > 0 {
+		return l.config.SupplierInterval(id, time.Duration(interval)*time.Millisecond)
+	}
+	return nil
+}
+
+func (l ConfigLoader) loadProfile(
+	config ConfigPartial,
+	profile string,
+) (ConfigSupplier, error) {
+	// check if the supplier is a file supplier that can have
+	// a profile overlay file
+	sConfig := struct {
+		Type string
+		Path string
+	}{}
+	if _, e := config.Populate("", &sConfig); e != nil {
+		return nil, e
+	}
+	if (sConfig.Type != ConfigTypeFile && sConfig.Type != ConfigTypeObsFile) || sConfig.Path == "" {
+		return nil, nil
+	}
+	// create the profile overlay supplier, ignoring
+	// non-existent overlay files
+	overlay := config.Clone()
+	overlay["path"] = configProfilePath(sConfig.Path, profile)
+	supplier, e := l.supplierFactory.Create(&overlay)
+	if e != nil {
+		if errors.Is(e, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, e
+	}
+	return supplier, nil
+}
+
+func configProfileActive(
+	profiles []interface{},
+) bool {
+	// unrestricted suppliers are always active
+	if len(profiles) == 0 {
+		return true
+	}
+	// check if any of the listed profiles is active
+	for _, profile := range profiles {
+		for _, active := range ConfigProfiles {
+			if fmt.Sprintf("%v", profile) == active {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func configProfilePath(
+	path,
+	profile string,
+) string {
+	// add the profile name before the path extension
+	ext := filepath.Ext(path)
+	return strings.TrimSuffix(path, ext) + "." + profile + ext
 }
 
 // ----------------------------------------------------------------------------
@@ -2901,12 +8007,15 @@ func (sr ConfigServiceRegister) Provide(
 	_ = container.Add(ConfigFileSourceCreatorContainerID, NewConfigFileSourceCreator, ConfigSupplierCreatorTag)
 	_ = container.Add(ConfigObsFileSourceCreatorContainerID, NewConfigObsFileSourceCreator, ConfigSupplierCreatorTag)
 	_ = container.Add(ConfigDirSourceCreatorContainerID, NewConfigDirSourceCreator, ConfigSupplierCreatorTag)
-	_ = container.Add(ConfigRestSourceCreatorContainerID, NewConfigRestSourceCreator, ConfigSupplierCreatorTag)
-	_ = container.Add(ConfigObsRestSourceCreatorContainerID, NewConfigObsRestSourceCreator, ConfigSupplierCreatorTag)
+	_ = container.Add(ConfigEmbeddedFileSourceCreatorContainerID, sr.getEmbeddedFileSourceCreator(container), ConfigSupplierCreatorTag)
+	_ = container.Add(ConfigEmbeddedDirSourceCreatorContainerID, sr.getEmbeddedDirSourceCreator(container), ConfigSupplierCreatorTag)
+	_ = container.Add(ConfigRestSourceCreatorContainerID, sr.getRestSourceCreator(container), ConfigSupplierCreatorTag)
+	_ = container.Add(ConfigObsRestSourceCreatorContainerID, sr.getObsRestSourceCreator(container), ConfigSupplierCreatorTag)
+	_ = container.Add(ConfigCommandSourceCreatorContainerID, NewConfigCommandSourceCreator, ConfigSupplierCreatorTag)
 	_ = container.Add(ConfigAllSupplierCreatorsContainerID, sr.getSupplierCreators(container))
 	_ = container.Add(ConfigSupplierFactoryContainerID, NewConfigSupplierFactory)
 	_ = container.Add(ConfigContainerID, NewConfig)
-	_ = container.Add(ConfigLoaderContainerID, NewConfigLoader)
+	_ = container.Add(ConfigLoaderContainerID, sr.getLoaderConstructor())
 	return nil
 }
 
@@ -2931,19 +8040,56 @@ func (sr ConfigServiceRegister) Boot(
 	return loader.Load()
 }
 
+func (sr ConfigServiceRegister) getRestSourceCreator(
+	container *ServiceContainer,
+) func(parserFactory *ConfigParserFactory) (*ConfigRestSourceCreator, error) {
+	return func(parserFactory *ConfigParserFactory) (*ConfigRestSourceCreator, error) {
+		return NewConfigRestSourceCreator(parserFactory, sr.getFileSystem(container))
+	}
+}
+
+func (sr ConfigServiceRegister) getObsRestSourceCreator(
+	container *ServiceContainer,
+) func(parserFactory *ConfigParserFactory) (*ConfigObsRestSourceCreator, error) {
+	return func(parserFactory *ConfigParserFactory) (*ConfigObsRestSourceCreator, error) {
+		return NewConfigObsRestSourceCreator(parserFactory, sr.getFileSystem(container))
+	}
+}
+
+func (ConfigServiceRegister) getFileSystem(
+	container *ServiceContainer,
+) afero.Fs {
+	// retrieve the file system service, if registered in the provider
+	if !container.Has(FileSystemContainerID) {
+		return nil
+	}
+	entry, e := container.Get(FileSystemContainerID)
+	if e != nil {
+		return nil
+	}
+	fileSystem, _ := entry.(afero.Fs)
+	return fileSystem
+}
+
+func (ConfigServiceRegister) getLoaderConstructor() func(config *Config, supplierFactory *ConfigSupplierFactory, fileSystem afero.Fs) (*ConfigLoader, error) {
+	return func(config *Config, supplierFactory *ConfigSupplierFactory, fileSystem afero.Fs) (*ConfigLoader, error) {
+		return NewConfigLoader(config, supplierFactory, fileSystem)
+	}
+}
+
 func (ConfigServiceRegister) getLoader(
 	container *ServiceContainer,
-) (*ConfigLoader, error) {
+) (ConfigLoaderInterface, error) {
 	// retrieve the loader service from the provider
 	entry, e := container.Get(ConfigLoaderContainerID)
 	if e != nil {
 		return nil, e
 	}
 	// validate the retrieved entry type
-	if instance, ok := entry.(*ConfigLoader); ok {
+	if instance, ok := entry.(ConfigLoaderInterface); ok {
 		return instance, nil
 	}
-	return nil, errConversion(entry, "*ConfigLoader")
+	return nil, errConversion(entry, "ConfigLoaderInterface")
 }
 
 func (ConfigServiceRegister) getParserCreators(
@@ -2982,6 +8128,22 @@ func (ConfigServiceRegister) getAggregateSuppliers(
 	}
 }
 
+func (ConfigServiceRegister) getEmbeddedFileSourceCreator(
+	container *ServiceContainer,
+) func(parserFactory *ConfigParserFactory) (*ConfigEmbeddedFileSourceCreator, error) {
+	return func(parserFactory *ConfigParserFactory) (*ConfigEmbeddedFileSourceCreator, error) {
+		return NewConfigEmbeddedFileSourceCreator(container, parserFactory)
+	}
+}
+
+func (ConfigServiceRegister) getEmbeddedDirSourceCreator(
+	container *ServiceContainer,
+) func(parserFactory *ConfigParserFactory) (*ConfigEmbeddedDirSourceCreator, error) {
+	return func(parserFactory *ConfigParserFactory) (*ConfigEmbeddedDirSourceCreator, error) {
+		return NewConfigEmbeddedDirSourceCreator(container, parserFactory)
+	}
+}
+
 func (ConfigServiceRegister) getSupplierCreators(
 	container *ServiceContainer,
 ) func() []ConfigSupplierCreator {
@@ -408,6 +408,102 @@ func (mr *MockConfigObsSupplierRecorder) Reload() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reload", reflect.TypeOf((*MockConfigObsSupplier)(nil).Reload))
 }
 
+// ----------------------------------------------------------------------------
+// ConfigRefreshSupplier
+// ----------------------------------------------------------------------------
+
+// MockConfigRefreshSupplier is a mock instance of RefreshSource interface
+type MockConfigRefreshSupplier struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigRefreshSupplierRecorder
+}
+
+var _ ConfigRefreshSupplier = &MockConfigRefreshSupplier{}
+
+// MockConfigRefreshSupplierRecorder is the mock recorder for MockConfigRefreshSupplier
+type MockConfigRefreshSupplierRecorder struct {
+	mock *MockConfigRefreshSupplier
+}
+
+// NewMockConfigRefreshSupplier creates a new mock instance
+func NewMockConfigRefreshSupplier(ctrl *gomock.Controller) *MockConfigRefreshSupplier {
+	mock := &MockConfigRefreshSupplier{ctrl: ctrl}
+	mock.recorder = &MockConfigRefreshSupplierRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockConfigRefreshSupplier) EXPECT() *MockConfigRefreshSupplierRecorder {
+	return m.recorder
+}
+
+// Close mocks base method
+func (m *MockConfigRefreshSupplier) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close
+func (mr *MockConfigRefreshSupplierRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockConfigRefreshSupplier)(nil).Close))
+}
+
+// Has mocks base method
+func (m *MockConfigRefreshSupplier) Has(path string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Has", path)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Has indicates an expected call of Has
+func (mr *MockConfigRefreshSupplierRecorder) Has(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Has", reflect.TypeOf((*MockConfigRefreshSupplier)(nil).Has), path)
+}
+
+// Get mocks base method
+func (m *MockConfigRefreshSupplier) Get(path string, def ...interface{}) (interface{}, error) {
+	m.ctrl.T.Helper()
+	var varargs []interface{}
+	varargs = append(varargs, path)
+	for _, a := range def {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Get", varargs...)
+	ret0, _ := ret[0].(interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockConfigRefreshSupplierRecorder) Get(path interface{}, def ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	var varargs []interface{}
+	varargs = append(varargs, path)
+	for _, a := range def {
+		varargs = append(varargs, a)
+	}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockConfigRefreshSupplier)(nil).Get), varargs...)
+}
+
+// Refresh mocks base method
+func (m *MockConfigRefreshSupplier) Refresh() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refresh indicates an expected call of Refresh
+func (mr *MockConfigRefreshSupplierRecorder) Refresh() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockConfigRefreshSupplier)(nil).Refresh))
+}
+
 // ----------------------------------------------------------------------------
 // Requester
 // ----------------------------------------------------------------------------
@@ -451,3 +547,46 @@ func (mr *MockConfigRestRequesterRecorder) Do(req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockConfigRestRequester)(nil).Do), req)
 }
+
+// ----------------------------------------------------------------------------
+// ConfigLoaderInterface
+// ----------------------------------------------------------------------------
+
+// MockConfigLoaderInterface is a mock instance of ConfigLoaderInterface interface.
+type MockConfigLoaderInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigLoaderInterfaceRecorder
+}
+
+var _ ConfigLoaderInterface = &MockConfigLoaderInterface{}
+
+// MockConfigLoaderInterfaceRecorder is the mock recorder for MockConfigLoaderInterface.
+type MockConfigLoaderInterfaceRecorder struct {
+	mock *MockConfigLoaderInterface
+}
+
+// NewMockConfigLoaderInterface creates a new mock instance.
+func NewMockConfigLoaderInterface(ctrl *gomock.Controller) *MockConfigLoaderInterface {
+	mock := &MockConfigLoaderInterface{ctrl: ctrl}
+	mock.recorder = &MockConfigLoaderInterfaceRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfigLoaderInterface) EXPECT() *MockConfigLoaderInterfaceRecorder {
+	return m.recorder
+}
+
+// Load mocks base method.
+func (m *MockConfigLoaderInterface) Load() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockConfigLoaderInterfaceRecorder) Load() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockConfigLoaderInterface)(nil).Load))
+}
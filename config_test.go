@@ -1,18 +1,27 @@
 package slate
 
 import (
+	"context"
+	"crypto/tls"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
+	"syscall"
 	"testing"
+	"testing/fstest"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 )
 
 func Test_config_err(t *testing.T) {
@@ -451,6 +460,30 @@ func Test_ConfigPartial(t *testing.T) {
 					value:    123,
 					expected: ConfigPartial{"test": ConfigPartial{"node": 123}},
 				},
+				{ // set to existing list element
+					partial:  ConfigPartial{"list": []interface{}{1, ConfigPartial{"name": "a"}}},
+					path:     "list[1].name",
+					value:    "b",
+					expected: ConfigPartial{"list": []interface{}{1, ConfigPartial{"name": "b"}}},
+				},
+				{ // set to existing list element by numeric key
+					partial:  ConfigPartial{"list": []interface{}{1, 2}},
+					path:     "list.0",
+					value:    3,
+					expected: ConfigPartial{"list": []interface{}{3, 2}},
+				},
+				{ // append to list
+					partial:  ConfigPartial{"list": []interface{}{1}},
+					path:     "list[1]",
+					value:    2,
+					expected: ConfigPartial{"list": []interface{}{1, 2}},
+				},
+				{ // set to quoted key
+					partial:  ConfigPartial{},
+					path:     `hosts["db.internal"].port`,
+					value:    123,
+					expected: ConfigPartial{"hosts": ConfigPartial{"db.internal": ConfigPartial{"port": 123}}},
+				},
 			}
 
 			for _, s := range scenarios {
@@ -465,6 +498,25 @@ func Test_ConfigPartial(t *testing.T) {
 				}
 			}
 		})
+
+		t.Run("error on malformed path", func(t *testing.T) {
+			for _, path := range []string{"list[1", `hosts["db.internal].port`, `hosts["db"x].port`} {
+				if chk, e := (&ConfigPartial{}).Set(path, 123); chk != nil {
+					t.Error("unexpected valid partial reference")
+				} else if !errors.Is(e, ErrInvalidConfigPath) {
+					t.Errorf("returned (%v) when expecting (%v)", e, ErrInvalidConfigPath)
+				}
+			}
+		})
+
+		t.Run("error on out of range list index", func(t *testing.T) {
+			sut := ConfigPartial{"list": []interface{}{1}}
+			if chk, e := sut.Set("list[2]", 123); chk != nil {
+				t.Error("unexpected valid partial reference")
+			} else if !errors.Is(e, ErrConfigPathNotFound) {
+				t.Errorf("returned (%v) when expecting (%v)", e, ErrConfigPathNotFound)
+			}
+		})
 	})
 
 	t.Run("Get", func(t *testing.T) {
@@ -624,6 +676,38 @@ func Test_ConfigPartial(t *testing.T) {
 				}
 			}
 		})
+
+		t.Run("retrieve with list indexes and quoted keys", func(t *testing.T) {
+			sut := ConfigPartial{
+				"items": []interface{}{"a", "b", ConfigPartial{"name": "c"}},
+				"hosts": ConfigPartial{"db.internal": ConfigPartial{"port": 123}},
+				"files": ConfigPartial{`it's "quoted"`: true},
+			}
+			scenarios := []struct {
+				path     string
+				expected interface{}
+			}{
+				{path: "items[2].name", expected: "c"},
+				{path: "items.1", expected: "b"},
+				{path: "[\"items\"][0]", expected: "a"},
+				{path: `hosts["db.internal"].port`, expected: 123},
+				{path: `hosts['db.internal'].port`, expected: 123},
+				{path: `files["it's \"quoted\""]`, expected: true},
+			}
+
+			for _, s := range scenarios {
+				if check, e := sut.Get(s.path); e != nil {
+					t.Errorf("unexpected (%v) error on (%s) path", e, s.path)
+				} else if !reflect.DeepEqual(check, s.expected) {
+					t.Errorf("returned (%v) on (%s) path when expecting (%v)", check, s.path, s.expected)
+				}
+			}
+			for _, path := range []string{"items[3]", "items[0].name", "hosts[0]", "hosts.db.internal.port"} {
+				if sut.Has(path) {
+					t.Errorf("found the (%s) path", path)
+				}
+			}
+		})
 	})
 
 	t.Run("Bool", func(t *testing.T) {
@@ -1056,6 +1140,437 @@ func Test_ConfigPartial(t *testing.T) {
 				t.Errorf("(%s) when merging (%v) and (%v), expecting (%v)", check, data1, data2, expected)
 			}
 		})
+
+		t.Run("tombstone removes the key", func(t *testing.T) {
+			check := ConfigPartial{"node1": "value1", "node2": ConfigPartial{"node3": "value3", "node4": "value4"}}
+			check.Merge(ConfigPartial{
+				"node1": ConfigMergeTombstone,
+				"node2": ConfigPartial{"node3": ConfigMergeTombstone},
+				"node5": ConfigPartial{"node6": ConfigMergeTombstone, "node7": "value7"},
+			})
+			expected := ConfigPartial{"node2": ConfigPartial{"node4": "value4"}, "node5": ConfigPartial{"node7": "value7"}}
+
+			if !reflect.DeepEqual(check, expected) {
+				t.Errorf("(%v) when expecting (%v)", check, expected)
+			}
+		})
+
+		t.Run("merges lists by strategy", func(t *testing.T) {
+			scenarios := []struct {
+				strategy ConfigMergeStrategy
+				partial1 ConfigPartial
+				partial2 ConfigPartial
+				expected ConfigPartial
+			}{
+				{ // _test replace strategy
+					strategy: ConfigMergeStrategy{Path: "node.list", Strategy: ConfigMergeReplace},
+					partial1: ConfigPartial{"node": ConfigPartial{"list": []interface{}{1, 2}}},
+					partial2: ConfigPartial{"node": ConfigPartial{"list": []interface{}{3}}},
+					expected: ConfigPartial{"node": ConfigPartial{"list": []interface{}{3}}},
+				},
+				{ // _test append strategy
+					strategy: ConfigMergeStrategy{Path: "node.list", Strategy: ConfigMergeAppend},
+					partial1: ConfigPartial{"node": ConfigPartial{"list": []interface{}{1, 2}}},
+					partial2: ConfigPartial{"node": ConfigPartial{"list": []interface{}{3}}},
+					expected: ConfigPartial{"node": ConfigPartial{"list": []interface{}{1, 2, 3}}},
+				},
+				{ // _test prepend strategy
+					strategy: ConfigMergeStrategy{Path: "node.list", Strategy: ConfigMergePrepend},
+					partial1: ConfigPartial{"node": ConfigPartial{"list": []interface{}{1, 2}}},
+					partial2: ConfigPartial{"node": ConfigPartial{"list": []interface{}{3}}},
+					expected: ConfigPartial{"node": ConfigPartial{"list": []interface{}{3, 1, 2}}},
+				},
+				{ // _test strategy of a non-matching path
+					strategy: ConfigMergeStrategy{Path: "node", Strategy: ConfigMergeAppend},
+					partial1: ConfigPartial{"node": ConfigPartial{"list": []interface{}{1, 2}}},
+					partial2: ConfigPartial{"node": ConfigPartial{"list": []interface{}{3}}},
+					expected: ConfigPartial{"node": ConfigPartial{"list": []interface{}{3}}},
+				},
+				{ // _test strategy of a wildcard path
+					strategy: ConfigMergeStrategy{Path: "*.list", Strategy: ConfigMergeAppend},
+					partial1: ConfigPartial{"node": ConfigPartial{"list": []interface{}{1, 2}}},
+					partial2: ConfigPartial{"node": ConfigPartial{"list": []interface{}{3}}},
+					expected: ConfigPartial{"node": ConfigPartial{"list": []interface{}{1, 2, 3}}},
+				},
+				{ // _test merge by key strategy
+					strategy: ConfigMergeStrategy{Path: "list", Strategy: ConfigMergeByKey, Key: "id"},
+					partial1: ConfigPartial{"list": []interface{}{
+						ConfigPartial{"id": "a", "field1": 1, "field2": 2},
+						ConfigPartial{"id": "b", "field1": 1},
+					}},
+					partial2: ConfigPartial{"list": []interface{}{
+						ConfigPartial{"id": "a", "field2": 3, "field1": ConfigMergeTombstone},
+						ConfigPartial{"id": "c", "field1": 4},
+						"scalar",
+					}},
+					expected: ConfigPartial{"list": []interface{}{
+						ConfigPartial{"id": "a", "field2": 3},
+						ConfigPartial{"id": "b", "field1": 1},
+						ConfigPartial{"id": "c", "field1": 4},
+						"scalar",
+					}},
+				},
+			}
+
+			for _, s := range scenarios {
+				check := s.partial1.Clone()
+				check.Merge(s.partial2, s.strategy)
+
+				if !reflect.DeepEqual(check, s.expected) {
+					t.Errorf("(%v) when merging (%v) and (%v), expecting (%v)", check, s.partial1, s.partial2, s.expected)
+				}
+			}
+		})
+	})
+
+	t.Run("NewConfigMergeStrategies", func(t *testing.T) {
+		t.Run("error on non-partial entry", func(t *testing.T) {
+			if _, e := NewConfigMergeStrategies([]interface{}{"entry"}); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrConversion) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrConversion)
+			}
+		})
+
+		t.Run("error on invalid strategy", func(t *testing.T) {
+			if _, e := NewConfigMergeStrategies([]interface{}{ConfigPartial{"path": "node", "strategy": "invalid"}}); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrInvalidConfigMergeStrategy) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrInvalidConfigMergeStrategy)
+			}
+		})
+
+		t.Run("parse strategies with default key", func(t *testing.T) {
+			expected := []ConfigMergeStrategy{
+				{Path: "node1", Strategy: ConfigMergeAppend, Key: "id"},
+				{Path: "node2", Strategy: ConfigMergeByKey, Key: "name"},
+			}
+
+			check, e := NewConfigMergeStrategies([]interface{}{
+				ConfigPartial{"path": "node1", "strategy": ConfigMergeAppend},
+				ConfigPartial{"path": "node2", "strategy": ConfigMergeByKey, "key": "name"},
+			})
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(check, expected):
+				t.Errorf("(%v) when expecting (%v)", check, expected)
+			}
+		})
+	})
+
+	t.Run("Duration", func(t *testing.T) {
+		t.Run("convert the stored value", func(t *testing.T) {
+			scenarios := []struct {
+				value    interface{}
+				expected time.Duration
+			}{
+				{ // _test duration value
+					value:    time.Second,
+					expected: time.Second,
+				},
+				{ // _test duration string
+					value:    "1m30s",
+					expected: 90 * time.Second,
+				},
+				{ // _test millisecond integer
+					value:    1500,
+					expected: 1500 * time.Millisecond,
+				},
+				{ // _test millisecond float
+					value:    float64(250),
+					expected: 250 * time.Millisecond,
+				},
+				{ // _test millisecond string (env)
+					value:    "5",
+					expected: 5 * time.Millisecond,
+				},
+			}
+
+			for _, s := range scenarios {
+				sut := ConfigPartial{"node": s.value}
+				if check, e := sut.Duration("node"); e != nil {
+					t.Errorf("unexpected (%v) error", e)
+				} else if check != s.expected {
+					t.Errorf("(%v) value when expecting : %v", check, s.expected)
+				}
+			}
+		})
+
+		t.Run("return conversion error on invalid value", func(t *testing.T) {
+			sut := ConfigPartial{"node": "1 minute"}
+
+			check, e := sut.Duration("node")
+			switch {
+			case check != 0:
+				t.Errorf("unexpected value : %v", check)
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrConversion):
+				t.Errorf("not convertion error : %v", e)
+			}
+		})
+
+		t.Run("return simple value if the path don't exists", func(t *testing.T) {
+			sut := ConfigPartial{}
+
+			if check, e := sut.Duration("node", time.Minute); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if check != time.Minute {
+				t.Errorf("(%v) value when expecting : %v", check, time.Minute)
+			}
+		})
+	})
+
+	t.Run("Time", func(t *testing.T) {
+		t.Run("convert the stored value", func(t *testing.T) {
+			expected := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+			scenarios := []interface{}{
+				expected,
+				"2021-03-04T05:06:07Z",
+				expected.Unix(),
+				float64(expected.Unix()),
+				strconv.FormatInt(expected.Unix(), 10),
+			}
+
+			for _, value := range scenarios {
+				sut := ConfigPartial{"node": value}
+				if check, e := sut.Time("node"); e != nil {
+					t.Errorf("unexpected (%v) error", e)
+				} else if !check.Equal(expected) {
+					t.Errorf("(%v) value when expecting : %v", check, expected)
+				}
+			}
+		})
+
+		t.Run("return conversion error on invalid value", func(t *testing.T) {
+			sut := ConfigPartial{"node": "yesterday"}
+
+			if _, e := sut.Time("node"); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrConversion) {
+				t.Errorf("not convertion error : %v", e)
+			}
+		})
+	})
+
+	t.Run("Uint", func(t *testing.T) {
+		t.Run("convert the stored value", func(t *testing.T) {
+			for _, value := range []interface{}{123, int64(123), float64(123), "123", uint(123)} {
+				sut := ConfigPartial{"node": value}
+				if check, e := sut.Uint("node"); e != nil {
+					t.Errorf("unexpected (%v) error", e)
+				} else if check != 123 {
+					t.Errorf("(%v) value when expecting : 123", check)
+				}
+			}
+		})
+
+		t.Run("return conversion error on invalid value", func(t *testing.T) {
+			for _, value := range []interface{}{-1, "-1", 1.5, "abc", true} {
+				sut := ConfigPartial{"node": value}
+				if _, e := sut.Uint("node"); e == nil {
+					t.Errorf("didn't returned the expected error for (%v)", value)
+				} else if !errors.Is(e, ErrConversion) {
+					t.Errorf("not convertion error : %v", e)
+				}
+			}
+		})
+	})
+
+	t.Run("Int64", func(t *testing.T) {
+		t.Run("convert the stored value", func(t *testing.T) {
+			for _, value := range []interface{}{-123, int64(-123), float64(-123), " -123 "} {
+				sut := ConfigPartial{"node": value}
+				if check, e := sut.Int64("node"); e != nil {
+					t.Errorf("unexpected (%v) error", e)
+				} else if check != -123 {
+					t.Errorf("(%v) value when expecting : -123", check)
+				}
+			}
+		})
+
+		t.Run("return conversion error on invalid value", func(t *testing.T) {
+			for _, value := range []interface{}{1.5, "1.5", ConfigPartial{}} {
+				sut := ConfigPartial{"node": value}
+				if _, e := sut.Int64("node"); e == nil {
+					t.Errorf("didn't returned the expected error for (%v)", value)
+				} else if !errors.Is(e, ErrConversion) {
+					t.Errorf("not convertion error : %v", e)
+				}
+			}
+		})
+	})
+
+	t.Run("ByteSize", func(t *testing.T) {
+		t.Run("convert the stored value", func(t *testing.T) {
+			scenarios := []struct {
+				value    interface{}
+				expected int64
+			}{
+				{value: 1024, expected: 1024},
+				{value: "512", expected: 512},
+				{value: "10B", expected: 10},
+				{value: "10MB", expected: 10 * 1000 * 1000},
+				{value: "10 mb", expected: 10 * 1000 * 1000},
+				{value: "1.5KiB", expected: 1536},
+				{value: "2GiB", expected: 2 << 30},
+			}
+
+			for _, s := range scenarios {
+				sut := ConfigPartial{"node": s.value}
+				if check, e := sut.ByteSize("node"); e != nil {
+					t.Errorf("unexpected (%v) error", e)
+				} else if check != s.expected {
+					t.Errorf("(%v) value when expecting : %v", check, s.expected)
+				}
+			}
+		})
+
+		t.Run("return conversion error on invalid value", func(t *testing.T) {
+			for _, value := range []interface{}{"10XB", "MB", "-10MB", true} {
+				sut := ConfigPartial{"node": value}
+				if _, e := sut.ByteSize("node"); e == nil {
+					t.Errorf("didn't returned the expected error for (%v)", value)
+				} else if !errors.Is(e, ErrConversion) {
+					t.Errorf("not convertion error : %v", e)
+				}
+			}
+		})
+	})
+
+	t.Run("StringList", func(t *testing.T) {
+		t.Run("convert the stored value", func(t *testing.T) {
+			scenarios := []struct {
+				value    interface{}
+				expected []string
+			}{
+				{value: []interface{}{"a", 1, true}, expected: []string{"a", "1", "true"}},
+				{value: "a, b,,c", expected: []string{"a", "b", "c"}},
+				{value: "", expected: []string{}},
+			}
+
+			for _, s := range scenarios {
+				sut := ConfigPartial{"node": s.value}
+				if check, e := sut.StringList("node"); e != nil {
+					t.Errorf("unexpected (%v) error", e)
+				} else if !reflect.DeepEqual(check, s.expected) {
+					t.Errorf("(%v) value when expecting : %v", check, s.expected)
+				}
+			}
+		})
+
+		t.Run("return conversion error on invalid value", func(t *testing.T) {
+			sut := ConfigPartial{"node": []interface{}{ConfigPartial{}}}
+
+			if _, e := sut.StringList("node"); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrConversion) {
+				t.Errorf("not convertion error : %v", e)
+			}
+		})
+	})
+
+	t.Run("StringMap", func(t *testing.T) {
+		t.Run("convert the stored value", func(t *testing.T) {
+			sut := ConfigPartial{"node": ConfigPartial{"a": "x", "b": 2}}
+			expected := map[string]string{"a": "x", "b": "2"}
+
+			if check, e := sut.StringMap("node"); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if !reflect.DeepEqual(check, expected) {
+				t.Errorf("(%v) value when expecting : %v", check, expected)
+			}
+		})
+
+		t.Run("return conversion error on invalid value", func(t *testing.T) {
+			for _, value := range []interface{}{"a=b", ConfigPartial{"a": ConfigPartial{}}} {
+				sut := ConfigPartial{"node": value}
+				if _, e := sut.StringMap("node"); e == nil {
+					t.Errorf("didn't returned the expected error for (%v)", value)
+				} else if !errors.Is(e, ErrConversion) {
+					t.Errorf("not convertion error : %v", e)
+				}
+			}
+		})
+	})
+
+	t.Run("case policy", func(t *testing.T) {
+		prev := ConfigCase
+		defer func() { ConfigCase = prev }()
+
+		t.Run("case-sensitive lookup on preserve policy", func(t *testing.T) {
+			ConfigCase = ConfigCasePreserve
+			sut := ConfigPartial{"Headers": ConfigPartial{"Content-Type": "json"}}
+
+			if check, _ := sut.Get("Headers.Content-Type"); check != "json" {
+				t.Errorf("(%v) when expecting (json)", check)
+			} else if sut.Has("headers.content-type") {
+				t.Error("found the path with a different case")
+			}
+		})
+
+		t.Run("case-insensitive lookup on insensitive policy", func(t *testing.T) {
+			ConfigCase = ConfigCaseInsensitive
+			sut := ConfigPartial{"Headers": ConfigPartial{"Content-Type": "json"}}
+
+			if check, _ := sut.Get("headers.content-type"); check != "json" {
+				t.Errorf("(%v) when expecting (json)", check)
+			}
+		})
+
+		t.Run("set and unset preserving the original keys on insensitive policy", func(t *testing.T) {
+			ConfigCase = ConfigCaseInsensitive
+			sut := ConfigPartial{"Headers": ConfigPartial{"Content-Type": "json", "Accept": "json"}}
+
+			_, _ = sut.Set("HEADERS.content-type", "xml")
+			_ = sut.unset("headers.ACCEPT")
+			expected := ConfigPartial{"Headers": ConfigPartial{"Content-Type": "xml"}}
+			if !reflect.DeepEqual(sut, expected) {
+				t.Errorf("(%v) when expecting (%v)", sut, expected)
+			}
+		})
+
+		t.Run("merge preserving the original keys on insensitive policy", func(t *testing.T) {
+			ConfigCase = ConfigCaseInsensitive
+			sut := ConfigPartial{"Headers": ConfigPartial{"Content-Type": "json"}}
+
+			sut.Merge(ConfigPartial{"headers": ConfigPartial{"CONTENT-TYPE": "xml"}})
+			expected := ConfigPartial{"Headers": ConfigPartial{"Content-Type": "xml"}}
+			if !reflect.DeepEqual(sut, expected) {
+				t.Errorf("(%v) when expecting (%v)", sut, expected)
+			}
+		})
+
+		t.Run("merge different keys on preserve policy", func(t *testing.T) {
+			ConfigCase = ConfigCasePreserve
+			sut := ConfigPartial{"Key": "value 1"}
+
+			sut.Merge(ConfigPartial{"key": "value 2"})
+			expected := ConfigPartial{"Key": "value 1", "key": "value 2"}
+			if !reflect.DeepEqual(sut, expected) {
+				t.Errorf("(%v) when expecting (%v)", sut, expected)
+			}
+		})
+
+		t.Run("populate matching the field names case-insensitively", func(t *testing.T) {
+			for _, policy := range []string{ConfigCasePreserve, ConfigCaseInsensitive} {
+				ConfigCase = policy
+				sut := ConfigPartial{"Server": ConfigPartial{"HostName": "localhost", "Headers": ConfigPartial{"X-Key": "value"}}}
+				data := struct {
+					Hostname string
+					Headers  ConfigPartial
+				}{}
+
+				if _, e := sut.Populate("Server", &data); e != nil {
+					t.Errorf("unexpected (%v) error", e)
+				} else if data.Hostname != "localhost" {
+					t.Errorf("(%v) when expecting (localhost)", data.Hostname)
+				} else if !reflect.DeepEqual(data.Headers, ConfigPartial{"X-Key": "value"}) {
+					t.Errorf("(%v) when expecting the preserved headers", data.Headers)
+				}
+			}
+		})
 	})
 
 	t.Run("Populate", func(t *testing.T) {
@@ -1075,6 +1590,24 @@ func Test_ConfigPartial(t *testing.T) {
 			}
 		})
 
+		t.Run("populate assignable values into interface struct fields", func(t *testing.T) {
+			data := ConfigPartial{"node": ConfigPartial{"field1": "value", "field2": []interface{}{"a", "b"}}}
+			target := struct {
+				Field1 interface{}
+				Field2 interface{}
+			}{}
+
+			_, e := data.Populate("node", &target)
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case target.Field1 != "value":
+				t.Errorf("populated the (%v) value", target.Field1)
+			case !reflect.DeepEqual(target.Field2, []interface{}{"a", "b"}):
+				t.Errorf("populated the (%v) value", target.Field2)
+			}
+		})
+
 		t.Run("error on populating an invalid type", func(t *testing.T) {
 			data := ConfigPartial{"field1": 123, "field2": ConfigPartial{"field1": 123, "field2": 456}}
 			path := "field1"
@@ -1317,40 +1850,155 @@ func Test_ConfigPartial(t *testing.T) {
 	})
 }
 
-func Test_ConfigConvert(t *testing.T) {
-	t.Run("Convert float32 into int", func(t *testing.T) {
-		data := float32(123)
-		expected := 123
-
-		if check := ConfigConvert(data); !reflect.DeepEqual(check, expected) {
-			t.Errorf("resulted in (%v) when converting (%v), expecting (%v)", check, data, expected)
+func Test_ConfigValue(t *testing.T) {
+	t.Run("nil supplier", func(t *testing.T) {
+		check, e := ConfigValue[int](nil, "node")
+		switch {
+		case check != 0:
+			t.Errorf("unexpected value : %v", check)
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, ErrNilPointer):
+			t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
 		}
 	})
 
-	t.Run("Convert float64 into int", func(t *testing.T) {
-		data := float64(123)
-		expected := 123
+	t.Run("return path not found error if no default is given", func(t *testing.T) {
+		partial := ConfigPartial{}
 
-		if check := ConfigConvert(data); !reflect.DeepEqual(check, expected) {
-			t.Errorf("resulted in (%v) when converting (%v), expecting (%v)", check, data, expected)
+		if _, e := ConfigValue[int](&partial, "node"); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, ErrConfigPathNotFound) {
+			t.Errorf("(%v) when expecting (%v)", e, ErrConfigPathNotFound)
 		}
 	})
 
-	t.Run("Convert map", func(t *testing.T) {
-		data := map[string]interface{}{"node": "value"}
-		expected := ConfigPartial{"node": "value"}
+	t.Run("return default value if the path don't exists", func(t *testing.T) {
+		partial := ConfigPartial{}
 
-		if check := ConfigConvert(data); !reflect.DeepEqual(check, expected) {
-			t.Errorf("resulted in (%v) when converting (%v), expecting (%v)", check, data, expected)
+		if check, e := ConfigValue(&partial, "node", time.Minute); e != nil {
+			t.Errorf("unexpected (%v) error", e)
+		} else if check != time.Minute {
+			t.Errorf("(%v) value when expecting : %v", check, time.Minute)
 		}
 	})
 
-	t.Run("Convert partial", func(t *testing.T) {
-		data := ConfigPartial{"node": "value"}
-		expected := ConfigPartial{"node": "value"}
+	t.Run("leniently convert scalar values", func(t *testing.T) {
+		partial := ConfigPartial{
+			"bool":     "true",
+			"int":      "5",
+			"int8":     float64(12),
+			"uint16":   "80",
+			"float":    "1.5",
+			"string":   123,
+			"duration": 200,
+			"list":     "a,b",
+		}
 
-		if check := ConfigConvert(data); !reflect.DeepEqual(check, expected) {
-			t.Errorf("resulted in (%v) when converting (%v), expecting (%v)", check, data, expected)
+		if check, e := ConfigValue[bool](&partial, "bool"); e != nil || !check {
+			t.Errorf("unexpected (%v, %v) bool conversion", check, e)
+		}
+		if check, e := ConfigValue[int](&partial, "int"); e != nil || check != 5 {
+			t.Errorf("unexpected (%v, %v) int conversion", check, e)
+		}
+		if check, e := ConfigValue[int8](&partial, "int8"); e != nil || check != 12 {
+			t.Errorf("unexpected (%v, %v) int8 conversion", check, e)
+		}
+		if check, e := ConfigValue[uint16](&partial, "uint16"); e != nil || check != 80 {
+			t.Errorf("unexpected (%v, %v) uint16 conversion", check, e)
+		}
+		if check, e := ConfigValue[float64](&partial, "float"); e != nil || check != 1.5 {
+			t.Errorf("unexpected (%v, %v) float conversion", check, e)
+		}
+		if check, e := ConfigValue[string](&partial, "string"); e != nil || check != "123" {
+			t.Errorf("unexpected (%v, %v) string conversion", check, e)
+		}
+		if check, e := ConfigValue[time.Duration](&partial, "duration"); e != nil || check != 200*time.Millisecond {
+			t.Errorf("unexpected (%v, %v) duration conversion", check, e)
+		}
+		if check, e := ConfigValue[[]string](&partial, "list"); e != nil || !reflect.DeepEqual(check, []string{"a", "b"}) {
+			t.Errorf("unexpected (%v, %v) list conversion", check, e)
+		}
+	})
+
+	t.Run("return conversion error on overflow", func(t *testing.T) {
+		partial := ConfigPartial{"node": 300}
+
+		if _, e := ConfigValue[uint8](&partial, "node"); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, ErrConversion) {
+			t.Errorf("(%v) when expecting (%v)", e, ErrConversion)
+		}
+	})
+
+	t.Run("populate struct values", func(t *testing.T) {
+		type data struct {
+			Field1 int
+			Field2 string
+		}
+		partial := ConfigPartial{"node": ConfigPartial{"field1": 1, "field2": "value"}}
+
+		if check, e := ConfigValue[data](&partial, "node"); e != nil {
+			t.Errorf("unexpected (%v) error", e)
+		} else if check.Field1 != 1 || check.Field2 != "value" {
+			t.Errorf("unexpected (%v) populated value", check)
+		}
+	})
+
+	t.Run("retrieve the value from a config", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ConfigObserveFrequency = 0
+		sut := NewConfig()
+		defer func() { _ = sut.Close() }()
+		supplier := NewMockConfigSupplier(ctrl)
+		supplier.EXPECT().Close().Times(1)
+		supplier.EXPECT().Get("").Return(ConfigPartial{"node": "5"}, nil).Times(1)
+		_ = sut.AddSupplier("supplier", 0, supplier)
+
+		if check, e := ConfigValue[int](sut, "node"); e != nil {
+			t.Errorf("unexpected (%v) error", e)
+		} else if check != 5 {
+			t.Errorf("(%v) value when expecting : 5", check)
+		}
+	})
+}
+
+func Test_ConfigConvert(t *testing.T) {
+	t.Run("Convert float32 into int", func(t *testing.T) {
+		data := float32(123)
+		expected := 123
+
+		if check := ConfigConvert(data); !reflect.DeepEqual(check, expected) {
+			t.Errorf("resulted in (%v) when converting (%v), expecting (%v)", check, data, expected)
+		}
+	})
+
+	t.Run("Convert float64 into int", func(t *testing.T) {
+		data := float64(123)
+		expected := 123
+
+		if check := ConfigConvert(data); !reflect.DeepEqual(check, expected) {
+			t.Errorf("resulted in (%v) when converting (%v), expecting (%v)", check, data, expected)
+		}
+	})
+
+	t.Run("Convert map", func(t *testing.T) {
+		data := map[string]interface{}{"node": "value"}
+		expected := ConfigPartial{"node": "value"}
+
+		if check := ConfigConvert(data); !reflect.DeepEqual(check, expected) {
+			t.Errorf("resulted in (%v) when converting (%v), expecting (%v)", check, data, expected)
+		}
+	})
+
+	t.Run("Convert partial", func(t *testing.T) {
+		data := ConfigPartial{"node": "value"}
+		expected := ConfigPartial{"node": "value"}
+
+		if check := ConfigConvert(data); !reflect.DeepEqual(check, expected) {
+			t.Errorf("resulted in (%v) when converting (%v), expecting (%v)", check, data, expected)
 		}
 	})
 
@@ -1479,6 +2127,19 @@ func Test_ConfigConvert(t *testing.T) {
 			t.Errorf("resulted in (%v) when converting (%v), expecting (%v)", check, data, expected)
 		}
 	})
+
+	t.Run("Convert preserving the keys case by policy", func(t *testing.T) {
+		prev := ConfigCase
+		ConfigCase = ConfigCasePreserve
+		defer func() { ConfigCase = prev }()
+
+		data := map[string]interface{}{"X-Header": map[interface{}]interface{}{"KeyID": "value"}}
+		expected := ConfigPartial{"X-Header": ConfigPartial{"KeyID": "value"}}
+
+		if check := ConfigConvert(data); !reflect.DeepEqual(check, expected) {
+			t.Errorf("resulted in (%v) when converting (%v), expecting (%v)", check, data, expected)
+		}
+	})
 }
 
 func Test_ConfigParserFactory(t *testing.T) {
@@ -2317,6 +2978,96 @@ func Test_ConfigAggregateSource(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("Reload", func(t *testing.T) {
+		t.Run("don't merge if no observable supplier changed", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("", ConfigPartial{}).Return(ConfigPartial{"node": "value1"}, nil).Times(1)
+			supplier2 := NewMockConfigObsSupplier(ctrl)
+			supplier2.EXPECT().Get("", ConfigPartial{}).Return(ConfigPartial{"node": "value2"}, nil).Times(1)
+			supplier2.EXPECT().Reload().Return(false, nil).Times(1)
+			sut, _ := NewConfigAggregateSource([]ConfigSupplier{supplier1, supplier2})
+
+			if reloaded, e := sut.Reload(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if reloaded {
+				t.Error("reported an unexpected change")
+			}
+		})
+
+		t.Run("merge again in order if an observable supplier changed", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			supplier1 := NewMockConfigObsSupplier(ctrl)
+			gomock.InOrder(
+				supplier1.EXPECT().Get("", ConfigPartial{}).Return(ConfigPartial{"node": "value1", "first": 1}, nil),
+				supplier1.EXPECT().Get("", ConfigPartial{}).Return(ConfigPartial{"node": "value3", "first": 2}, nil),
+			)
+			supplier1.EXPECT().Reload().Return(true, nil).Times(1)
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("", ConfigPartial{}).Return(ConfigPartial{"node": "value2"}, nil).Times(2)
+			sut, _ := NewConfigAggregateSource([]ConfigSupplier{supplier1, supplier2})
+
+			if reloaded, e := sut.Reload(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if !reloaded {
+				t.Error("didn't reported the change")
+			} else if !reflect.DeepEqual(sut.Partial, ConfigPartial{"node": "value2", "first": 2}) {
+				t.Errorf("merged the (%v) content", sut.Partial)
+			}
+		})
+
+		t.Run("merge the changes of the other suppliers on failure", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := fmt.Errorf("error message")
+			supplier1 := NewMockConfigObsSupplier(ctrl)
+			supplier1.EXPECT().Get("", ConfigPartial{}).Return(ConfigPartial{"node": "value1"}, nil).Times(2)
+			supplier1.EXPECT().Reload().Return(false, expected).Times(1)
+			supplier2 := NewMockConfigObsSupplier(ctrl)
+			gomock.InOrder(
+				supplier2.EXPECT().Get("", ConfigPartial{}).Return(ConfigPartial{"other": "value1"}, nil),
+				supplier2.EXPECT().Get("", ConfigPartial{}).Return(ConfigPartial{"other": "value2"}, nil),
+			)
+			supplier2.EXPECT().Reload().Return(true, nil).Times(1)
+			sut, _ := NewConfigAggregateSource([]ConfigSupplier{supplier1, supplier2})
+
+			reloaded, e := sut.Reload()
+			switch {
+			case !errors.Is(e, expected):
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			case !reloaded:
+				t.Error("didn't reported the change")
+			case !reflect.DeepEqual(sut.Partial, ConfigPartial{"node": "value1", "other": "value2"}):
+				t.Errorf("merged the (%v) content", sut.Partial)
+			}
+		})
+
+		t.Run("error while merging the changed content", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := fmt.Errorf("error message")
+			supplier := NewMockConfigObsSupplier(ctrl)
+			gomock.InOrder(
+				supplier.EXPECT().Get("", ConfigPartial{}).Return(ConfigPartial{"node": "value1"}, nil),
+				supplier.EXPECT().Get("", ConfigPartial{}).Return(nil, expected),
+			)
+			supplier.EXPECT().Reload().Return(true, nil).Times(1)
+			sut, _ := NewConfigAggregateSource([]ConfigSupplier{supplier})
+
+			if reloaded, e := sut.Reload(); !errors.Is(e, expected) {
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			} else if reloaded {
+				t.Error("reported an unexpected change")
+			}
+		})
+	})
 }
 
 func Test_ConfigAggregateSourceCreator(t *testing.T) {
@@ -2903,6 +3654,199 @@ func Test_ConfigFileSource(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("Refresh", func(t *testing.T) {
+		t.Run("re-read the file content", func(t *testing.T) {
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "config.yaml", []byte("node: value1"), 0o644)
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigYAMLDecoderCreator()})
+			sut, _ := NewConfigFileSource("config.yaml", ConfigFormatYAML, fileSystem, parserFactory)
+			_ = afero.WriteFile(fileSystem, "config.yaml", []byte("node: value2"), 0o644)
+
+			if e := sut.Refresh(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if check, _ := sut.Get("node"); check != "value2" {
+				t.Errorf("returned (%v) when expecting (value2)", check)
+			}
+		})
+	})
+
+	t.Run("include", func(t *testing.T) {
+		parserFactory := NewConfigParserFactory([]ConfigParserCreator{
+			NewConfigYAMLDecoderCreator(),
+			NewConfigJSONDecoderCreator(),
+		})
+
+		t.Run("merge the included files content", func(t *testing.T) {
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "config/app.yaml", []byte(`
+$include: [rdb.yaml, "log/*.yaml"]
+name: app
+rdb:
+  host: remote
+`), 0o644)
+			_ = afero.WriteFile(fileSystem, "config/rdb.yaml", []byte("rdb: {host: localhost, port: 3306}"), 0o644)
+			_ = afero.WriteFile(fileSystem, "config/log/console.yaml", []byte("log: {console: {level: debug}}"), 0o644)
+			_ = afero.WriteFile(fileSystem, "config/log/file.yaml", []byte("log: {file: {level: error}}"), 0o644)
+
+			sut, e := NewConfigFileSource("config/app.yaml", ConfigFormatYAML, fileSystem, parserFactory)
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case sut.Has(ConfigIncludeKey):
+				t.Error("stored the include directive")
+			case !reflect.DeepEqual(sut.Partial, ConfigPartial{
+				"name": "app",
+				"rdb":  ConfigPartial{"host": "remote", "port": 3306},
+				"log": ConfigPartial{
+					"console": ConfigPartial{"level": "debug"},
+					"file":    ConfigPartial{"level": "error"},
+				},
+			}):
+				t.Errorf("stored the (%v) partial", sut.Partial)
+			case len(sut.includes) != 3:
+				t.Errorf("stored the (%v) included files", sut.includes)
+			}
+		})
+
+		t.Run("resolve inner partial include directives", func(t *testing.T) {
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "app.yaml", []byte("rdb: {$include: rdb.json, port: 1}"), 0o644)
+			_ = afero.WriteFile(fileSystem, "rdb.json", []byte(`{"host": "localhost", "port": 2}`), 0o644)
+
+			sut, e := NewConfigFileSource("app.yaml", ConfigFormatYAML, fileSystem, parserFactory)
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(sut.Partial, ConfigPartial{"rdb": ConfigPartial{"host": "localhost", "port": 1}}):
+				t.Errorf("stored the (%v) partial", sut.Partial)
+			}
+		})
+
+		t.Run("error on missing included file", func(t *testing.T) {
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "app.yaml", []byte("$include: rdb.yaml"), 0o644)
+
+			if _, e := NewConfigFileSource("app.yaml", ConfigFormatYAML, fileSystem, parserFactory); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, os.ErrNotExist) {
+				t.Errorf("(%v) when expecting (%v)", e, os.ErrNotExist)
+			}
+		})
+
+		t.Run("error on invalid include directive", func(t *testing.T) {
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "app.yaml", []byte("$include: 123"), 0o644)
+
+			if _, e := NewConfigFileSource("app.yaml", ConfigFormatYAML, fileSystem, parserFactory); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrInvalidConfigInclude) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrInvalidConfigInclude)
+			}
+		})
+
+		t.Run("error on include cycle", func(t *testing.T) {
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "app.yaml", []byte("$include: sub/rdb.yaml"), 0o644)
+			_ = afero.WriteFile(fileSystem, "sub/rdb.yaml", []byte("$include: ../app.yaml"), 0o644)
+
+			if _, e := NewConfigFileSource("app.yaml", ConfigFormatYAML, fileSystem, parserFactory); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrInvalidConfigInclude) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrInvalidConfigInclude)
+			}
+		})
+	})
+
+	t.Run("template", func(t *testing.T) {
+		parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigYAMLDecoderCreator()})
+
+		t.Run("don't render the file if not enabled", func(t *testing.T) {
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "app.yaml", []byte(`node: "{{ b64enc \"value\" }}"`), 0o644)
+
+			sut, e := NewConfigFileSource("app.yaml", ConfigFormatYAML, fileSystem, parserFactory)
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(sut.Partial, ConfigPartial{"node": `{{ b64enc "value" }}`}):
+				t.Errorf("stored the (%v) partial", sut.Partial)
+			}
+		})
+
+		t.Run("render the file and included files content", func(t *testing.T) {
+			t.Setenv("SLATE_TEST_TEMPLATE_ENV", "production")
+			hostname, _ := os.Hostname()
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "app.yaml", []byte(`
+$include: rdb.yaml
+env: {{ env "SLATE_TEST_TEMPLATE_ENV" }}
+host: {{ hostname }}
+level: {{ env "SLATE_TEST_TEMPLATE_MISSING" | default "info" }}
+`), 0o644)
+			_ = afero.WriteFile(fileSystem, "rdb.yaml", []byte(`
+rdb:
+  user: {{ b64dec "dXNlcg==" }}
+  password: {{ file "secrets/password" }}
+  token: {{ file "secrets/password" | b64enc }}
+`), 0o644)
+			_ = afero.WriteFile(fileSystem, "secrets/password", []byte("secret\n"), 0o644)
+
+			sut, e := NewConfigFileSource("app.yaml", ConfigFormatYAML, fileSystem, parserFactory, ConfigFileOptions{Template: true})
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(sut.Partial, ConfigPartial{
+				"env":   "production",
+				"host":  hostname,
+				"level": "info",
+				"rdb":   ConfigPartial{"user": "user", "password": "secret", "token": "c2VjcmV0"},
+			}):
+				t.Errorf("stored the (%v) partial", sut.Partial)
+			}
+		})
+
+		t.Run("error on invalid template", func(t *testing.T) {
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "app.yaml", []byte("node: {{ unknown }}"), 0o644)
+
+			if _, e := NewConfigFileSource("app.yaml", ConfigFormatYAML, fileSystem, parserFactory, ConfigFileOptions{Template: true}); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrInvalidConfigTemplate) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrInvalidConfigTemplate)
+			}
+		})
+
+		t.Run("error on template function error", func(t *testing.T) {
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "app.yaml", []byte(`node: {{ file "missing" }}`), 0o644)
+
+			if _, e := NewConfigFileSource("app.yaml", ConfigFormatYAML, fileSystem, parserFactory, ConfigFileOptions{Template: true}); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrInvalidConfigTemplate) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrInvalidConfigTemplate)
+			}
+		})
+
+		t.Run("create the rendered file source from the definition", func(t *testing.T) {
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "app.yaml", []byte(`node: {{ "" | default "value" }}`), 0o644)
+			creator, _ := NewConfigObsFileSourceCreator(fileSystem, parserFactory)
+
+			sut, e := creator.Create(&ConfigPartial{
+				"type":     ConfigTypeObsFile,
+				"path":     "app.yaml",
+				"format":   ConfigFormatYAML,
+				"template": true,
+			})
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(sut.(*ConfigObsFileSource).Partial, ConfigPartial{"node": "value"}):
+				t.Errorf("stored the (%v) partial", sut.(*ConfigObsFileSource).Partial)
+			}
+		})
+	})
 }
 
 func Test_ConfigFileSourceCreator(t *testing.T) {
@@ -3506,6 +4450,33 @@ func Test_ConfigObsFileSource(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("Reload included files", func(t *testing.T) {
+		t.Run("reload if an included file has changed", func(t *testing.T) {
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "app.yaml", []byte("$include: rdb.yaml"), 0o644)
+			_ = afero.WriteFile(fileSystem, "rdb.yaml", []byte("host: localhost"), 0o644)
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigYAMLDecoderCreator()})
+			sut, _ := NewConfigObsFileSource("app.yaml", ConfigFormatYAML, fileSystem, parserFactory)
+
+			if reloaded, e := sut.Reload(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if reloaded {
+				t.Error("reloaded without changes")
+			}
+
+			_ = afero.WriteFile(fileSystem, "rdb.yaml", []byte("host: remote"), 0o644)
+			_ = fileSystem.Chtimes("rdb.yaml", time.Now().Add(time.Hour), time.Now().Add(time.Hour))
+
+			if reloaded, e := sut.Reload(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if !reloaded {
+				t.Error("didn't reloaded the changed included file")
+			} else if check, _ := sut.Get("host"); check != "remote" {
+				t.Errorf("returned (%v) when expecting (remote)", check)
+			}
+		})
+	})
 }
 
 func Test_ConfigObsFileSourceCreator(t *testing.T) {
@@ -3918,6 +4889,7 @@ func Test_ConfigDirSource(t *testing.T) {
 				Return(file, nil).
 				Times(1)
 			parserCreator := NewMockConfigParserCreator(ctrl)
+			parserCreator.EXPECT().Accept(ConfigFormatYAML).Return(false).Times(1)
 			parserCreator.EXPECT().Accept(ConfigFormatJSON).Return(false).Times(1)
 			parserFactory := NewConfigParserFactory([]ConfigParserCreator{parserCreator})
 
@@ -3958,7 +4930,7 @@ func Test_ConfigDirSource(t *testing.T) {
 			parser.EXPECT().Parse().Return(nil, expected).Times(1)
 			parser.EXPECT().Close().Return(nil).Times(1)
 			parserCreator := NewMockConfigParserCreator(ctrl)
-			parserCreator.EXPECT().Accept("format").Return(true).Times(1)
+			parserCreator.EXPECT().Accept(ConfigFormatYAML).Return(true).Times(2)
 			parserCreator.EXPECT().Create(file).Return(parser, nil).Times(1)
 			parserFactory := NewConfigParserFactory([]ConfigParserCreator{parserCreator})
 
@@ -3998,7 +4970,7 @@ func Test_ConfigDirSource(t *testing.T) {
 			parser.EXPECT().Parse().Return(partial, nil).Times(1)
 			parser.EXPECT().Close().Return(nil).Times(1)
 			parserCreator := NewMockConfigParserCreator(ctrl)
-			parserCreator.EXPECT().Accept("format").Return(true).Times(1)
+			parserCreator.EXPECT().Accept(ConfigFormatYAML).Return(true).Times(2)
 			parserCreator.EXPECT().Create(file).Return(parser, nil).Times(1)
 			parserFactory := NewConfigParserFactory([]ConfigParserCreator{parserCreator})
 
@@ -4038,7 +5010,7 @@ func Test_ConfigDirSource(t *testing.T) {
 			fileInfo.EXPECT().Name().Return(fileInfoName).Times(1)
 			subDirInfo := NewMockFileInfo(ctrl)
 			subDirInfo.EXPECT().IsDir().Return(true).Times(1)
-			subDirInfo.EXPECT().Name().Times(0)
+			subDirInfo.EXPECT().Name().Return("sub_dir").Times(1)
 			dir := NewMockFile(ctrl)
 			dir.EXPECT().Readdir(0).Return([]os.FileInfo{fileInfo, subDirInfo}, nil).Times(1)
 			dir.EXPECT().Close().Return(nil).Times(1)
@@ -4054,7 +5026,7 @@ func Test_ConfigDirSource(t *testing.T) {
 			parser.EXPECT().Parse().Return(partial, nil).Times(1)
 			parser.EXPECT().Close().Return(nil).Times(1)
 			parserCreator := NewMockConfigParserCreator(ctrl)
-			parserCreator.EXPECT().Accept("format").Return(true).Times(1)
+			parserCreator.EXPECT().Accept(ConfigFormatYAML).Return(true).Times(2)
 			parserCreator.EXPECT().Create(file).Return(parser, nil).Times(1)
 			parserFactory := NewConfigParserFactory([]ConfigParserCreator{parserCreator})
 
@@ -4115,7 +5087,7 @@ func Test_ConfigDirSource(t *testing.T) {
 			parser.EXPECT().Parse().Return(partial1, nil).Times(1)
 			parser.EXPECT().Close().Return(nil).Times(1)
 			parserCreator := NewMockConfigParserCreator(ctrl)
-			parserCreator.EXPECT().Accept("format").Return(true).Times(1)
+			parserCreator.EXPECT().Accept(ConfigFormatYAML).Return(true).Times(2)
 			parserCreator.EXPECT().Create(file).Return(parser, nil).Times(1)
 			parserFactory := NewConfigParserFactory([]ConfigParserCreator{parserCreator})
 
@@ -4180,10 +5152,7 @@ func Test_ConfigDirSource(t *testing.T) {
 			parser2.EXPECT().Parse().Return(partial2, nil).Times(1)
 			parser2.EXPECT().Close().Return(nil).Times(1)
 			parserCreator := NewMockConfigParserCreator(ctrl)
-			gomock.InOrder(
-				parserCreator.EXPECT().Accept("format").Return(true),
-				parserCreator.EXPECT().Accept("format").Return(true),
-			)
+			parserCreator.EXPECT().Accept(ConfigFormatYAML).Return(true).Times(4)
 			gomock.InOrder(
 				parserCreator.EXPECT().Create(file1).Return(parser1, nil),
 				parserCreator.EXPECT().Create(file2).Return(parser2, nil),
@@ -4214,64 +5183,202 @@ func Test_ConfigDirSource(t *testing.T) {
 			}
 		})
 	})
-}
 
-func Test_ConfigDirSourceCreator(t *testing.T) {
-	t.Run("NewConfigDirSourceCreator", func(t *testing.T) {
-		t.Run("nil file system adapter", func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
+	t.Run("parse mixed extension files by the detected format", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-			sut, e := NewConfigDirSourceCreator(nil, NewConfigParserFactory(nil))
+		fileSystem := afero.NewMemMapFs()
+		_ = afero.WriteFile(fileSystem, "config/a.yaml", []byte("a: yaml"), 0o644)
+		_ = afero.WriteFile(fileSystem, "config/b.json", []byte(`{"b": "json"}`), 0o644)
+		yamlParser := NewMockConfigParser(ctrl)
+		yamlParser.EXPECT().Parse().Return(&ConfigPartial{"a": "yaml"}, nil).Times(1)
+		yamlParser.EXPECT().Close().Return(nil).Times(1)
+		jsonParser := NewMockConfigParser(ctrl)
+		jsonParser.EXPECT().Parse().Return(&ConfigPartial{"b": "json"}, nil).Times(1)
+		jsonParser.EXPECT().Close().Return(nil).Times(1)
+		yamlCreator := NewMockConfigParserCreator(ctrl)
+		yamlCreator.EXPECT().Accept(ConfigFormatYAML).Return(true).Times(2)
+		yamlCreator.EXPECT().Accept(ConfigFormatJSON).Return(false).Times(2)
+		yamlCreator.EXPECT().Create(gomock.Any()).Return(yamlParser, nil).Times(1)
+		jsonCreator := NewMockConfigParserCreator(ctrl)
+		jsonCreator.EXPECT().Accept(ConfigFormatJSON).Return(true).Times(2)
+		jsonCreator.EXPECT().Create(gomock.Any()).Return(jsonParser, nil).Times(1)
+		parserFactory := NewConfigParserFactory([]ConfigParserCreator{yamlCreator, jsonCreator})
+
+		sut, e := NewConfigDirSource("config", ConfigFormatYAML, false, fileSystem, parserFactory)
+		switch {
+		case e != nil:
+			t.Errorf("unexpected (%v) error", e)
+		case !reflect.DeepEqual(sut.Partial, ConfigPartial{"a": "yaml", "b": "json"}):
+			t.Errorf("loaded the (%v) content", sut.Partial)
+		}
+	})
+
+	t.Run("options", func(t *testing.T) {
+		parserFactory := NewConfigParserFactory([]ConfigParserCreator{
+			NewConfigYAMLDecoderCreator(),
+			NewConfigJSONDecoderCreator(),
+		})
+		fileSystem := func() afero.Fs {
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "config/b.json", []byte(`{"node": "json", "json": true}`), 0o644)
+			_ = afero.WriteFile(fileSystem, "config/a.yaml", []byte("node: yaml\nyaml: true"), 0o644)
+			_ = afero.WriteFile(fileSystem, "config/c", []byte("node: plain"), 0o644)
+			_ = afero.WriteFile(fileSystem, "config/README.md", []byte("# config\n\n* list"), 0o644)
+			_ = afero.WriteFile(fileSystem, "config/rdb/primary.yml", []byte("host: localhost"), 0o644)
+			return fileSystem
+		}
+
+		t.Run("error on invalid pattern", func(t *testing.T) {
+			sut, e := NewConfigDirSource("config", ConfigFormatYAML, true, fileSystem(), parserFactory, ConfigDirOptions{
+				Include: []string{"["},
+			})
 			switch {
 			case sut != nil:
 				t.Error("returned a valid reference")
 			case e == nil:
 				t.Error("didn't returned the expected error")
-			case !errors.Is(e, ErrNilPointer):
-				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			case !errors.Is(e, ErrInvalidConfigSupplier):
+				t.Errorf("(%v) when expecting (%v)", e, ErrInvalidConfigSupplier)
 			}
 		})
 
-		t.Run("nil parser factory", func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			sut, e := NewConfigDirSourceCreator(NewMockFs(ctrl), nil)
+		t.Run("parse by extension in name order", func(t *testing.T) {
+			sut, e := NewConfigDirSource("config", ConfigFormatYAML, false, fileSystem(), parserFactory, ConfigDirOptions{
+				Exclude: []string{"*.md"},
+			})
 			switch {
-			case sut != nil:
-				t.Error("returned a valid reference")
-			case e == nil:
-				t.Error("didn't returned the expected error")
-			case !errors.Is(e, ErrNilPointer):
-				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(sut.Partial, ConfigPartial{"node": "plain", "json": true, "yaml": true}):
+				t.Errorf("loaded the (%v) content", sut.Partial)
 			}
 		})
 
-		t.Run("new file source factory creator", func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			fs := NewMockFs(ctrl)
-			parserFactory := NewConfigParserFactory(nil)
-
-			sut, e := NewConfigDirSourceCreator(fs, parserFactory)
+		t.Run("filter the included files", func(t *testing.T) {
+			sut, e := NewConfigDirSource("config", ConfigFormatYAML, true, fileSystem(), parserFactory, ConfigDirOptions{
+				Include: []string{"*.json", "rdb/*"},
+			})
 			switch {
 			case e != nil:
 				t.Errorf("unexpected (%v) error", e)
-			case sut == nil:
-				t.Error("didn't returned a valid reference")
-			case sut.fileSystem != fs:
-				t.Error("didn't stored the file system adapter reference")
-			case sut.parserFactory != parserFactory:
-				t.Error("didn't stored the parser factory reference")
+			case !reflect.DeepEqual(sut.Partial, ConfigPartial{"node": "json", "json": true, "host": "localhost"}):
+				t.Errorf("loaded the (%v) content", sut.Partial)
 			}
 		})
-	})
-
-	t.Run("Accept", func(t *testing.T) {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
+
+		t.Run("mount the files content by the relative path", func(t *testing.T) {
+			sut, e := NewConfigDirSource("config", ConfigFormatYAML, true, fileSystem(), parserFactory, ConfigDirOptions{
+				Include:   []string{"*.yaml", "*.yml"},
+				Namespace: true,
+			})
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(sut.Partial, ConfigPartial{
+				"a":   ConfigPartial{"node": "yaml", "yaml": true},
+				"rdb": ConfigPartial{"primary": ConfigPartial{"host": "localhost"}},
+			}):
+				t.Errorf("loaded the (%v) content", sut.Partial)
+			}
+		})
+
+		t.Run("render the files content", func(t *testing.T) {
+			fileSystem := fileSystem()
+			_ = afero.WriteFile(fileSystem, "config/rdb/primary.yml", []byte(`host: {{ "" | default "remote" }}`), 0o644)
+
+			sut, e := NewConfigDirSource("config", ConfigFormatYAML, true, fileSystem, parserFactory, ConfigDirOptions{
+				Include:  []string{"*.yml"},
+				Template: true,
+			})
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(sut.Partial, ConfigPartial{"host": "remote"}):
+				t.Errorf("loaded the (%v) content", sut.Partial)
+			}
+		})
+
+		t.Run("create the filtered dir source from the definition", func(t *testing.T) {
+			creator, _ := NewConfigDirSourceCreator(fileSystem(), parserFactory)
+
+			sut, e := creator.Create(&ConfigPartial{
+				"type":      ConfigTypeDir,
+				"path":      "config",
+				"recursive": true,
+				"include":   "*.yml, *.json",
+				"exclude":   []interface{}{"b.*"},
+				"namespace": true,
+			})
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(sut.(*ConfigDirSource).Partial, ConfigPartial{
+				"rdb": ConfigPartial{"primary": ConfigPartial{"host": "localhost"}},
+			}):
+				t.Errorf("loaded the (%v) content", sut.(*ConfigDirSource).Partial)
+			}
+		})
+	})
+}
+
+func Test_ConfigDirSourceCreator(t *testing.T) {
+	t.Run("NewConfigDirSourceCreator", func(t *testing.T) {
+		t.Run("nil file system adapter", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sut, e := NewConfigDirSourceCreator(nil, NewConfigParserFactory(nil))
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("nil parser factory", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sut, e := NewConfigDirSourceCreator(NewMockFs(ctrl), nil)
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("new file source factory creator", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fs := NewMockFs(ctrl)
+			parserFactory := NewConfigParserFactory(nil)
+
+			sut, e := NewConfigDirSourceCreator(fs, parserFactory)
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case sut == nil:
+				t.Error("didn't returned a valid reference")
+			case sut.fileSystem != fs:
+				t.Error("didn't stored the file system adapter reference")
+			case sut.parserFactory != parserFactory:
+				t.Error("didn't stored the parser factory reference")
+			}
+		})
+	})
+
+	t.Run("Accept", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
 		sut, _ := NewConfigDirSourceCreator(NewMockFs(ctrl), NewConfigParserFactory(nil))
 
@@ -4452,7 +5559,7 @@ func Test_ConfigDirSourceCreator(t *testing.T) {
 			parser.EXPECT().Parse().Return(&expected, nil).Times(1)
 			parser.EXPECT().Close().Return(nil).Times(1)
 			parserCreator := NewMockConfigParserCreator(ctrl)
-			parserCreator.EXPECT().Accept("format").Return(true).Times(1)
+			parserCreator.EXPECT().Accept(ConfigFormatYAML).Return(true).Times(2)
 			parserCreator.EXPECT().Create(file).Return(parser, nil).Times(1)
 			parserFactory := NewConfigParserFactory([]ConfigParserCreator{parserCreator})
 
@@ -4504,7 +5611,7 @@ func Test_ConfigDirSourceCreator(t *testing.T) {
 			parser.EXPECT().Parse().Return(&expected, nil).Times(1)
 			parser.EXPECT().Close().Return(nil).Times(1)
 			parserCreator := NewMockConfigParserCreator(ctrl)
-			parserCreator.EXPECT().Accept(ConfigFormatYAML).Return(true).Times(1)
+			parserCreator.EXPECT().Accept(ConfigFormatYAML).Return(true).Times(2)
 			parserCreator.EXPECT().Create(file).Return(parser, nil).Times(1)
 			parserFactory := NewConfigParserFactory([]ConfigParserCreator{parserCreator})
 
@@ -4533,6 +5640,230 @@ func Test_ConfigDirSourceCreator(t *testing.T) {
 	})
 }
 
+func Test_ConfigEmbeddedSourceCreator(t *testing.T) {
+	fileSystem := fstest.MapFS{
+		"config.yaml":        {Data: []byte("field: value")},
+		"config/base.yaml":   {Data: []byte("base: value")},
+		"config/extra.json":  {Data: []byte(`{"extra": "value"}`)},
+		"config/sub/x.yaml":  {Data: []byte("sub: value")},
+		"config/ignore.yaml": {Data: []byte("ignore: value")},
+	}
+	parserFactory := NewConfigParserFactory([]ConfigParserCreator{
+		NewConfigYAMLDecoderCreator(),
+		NewConfigJSONDecoderCreator(),
+	})
+	container := NewServiceContainer()
+	_ = container.Add("embedded", func() fs.FS { return fileSystem })
+	_ = container.Add("invalid", func() string { return "string" })
+
+	t.Run("NewConfigEmbeddedFileSourceCreator", func(t *testing.T) {
+		t.Run("nil container", func(t *testing.T) {
+			sut, e := NewConfigEmbeddedFileSourceCreator(nil, parserFactory)
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("nil parser factory", func(t *testing.T) {
+			sut, e := NewConfigEmbeddedFileSourceCreator(container, nil)
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("new embedded file source creator", func(t *testing.T) {
+			sut, e := NewConfigEmbeddedFileSourceCreator(container, parserFactory)
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case sut == nil:
+				t.Error("didn't returned a valid reference")
+			case sut.container != container:
+				t.Error("didn't stored the container reference")
+			case sut.parserFactory != parserFactory:
+				t.Error("didn't stored the parser factory reference")
+			}
+		})
+	})
+
+	t.Run("NewConfigEmbeddedDirSourceCreator", func(t *testing.T) {
+		t.Run("nil container", func(t *testing.T) {
+			sut, e := NewConfigEmbeddedDirSourceCreator(nil, parserFactory)
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("nil parser factory", func(t *testing.T) {
+			sut, e := NewConfigEmbeddedDirSourceCreator(container, nil)
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+	})
+
+	t.Run("Accept", func(t *testing.T) {
+		fileCreator, _ := NewConfigEmbeddedFileSourceCreator(container, parserFactory)
+		dirCreator, _ := NewConfigEmbeddedDirSourceCreator(container, parserFactory)
+
+		scenarios := []struct {
+			config *ConfigPartial
+			file   bool
+			dir    bool
+		}{
+			{ // nil config
+				config: nil,
+			},
+			{ // invalid type
+				config: &ConfigPartial{"type": 123},
+			},
+			{ // file type
+				config: &ConfigPartial{"type": ConfigTypeFile},
+			},
+			{ // embedded file type
+				config: &ConfigPartial{"type": ConfigTypeEmbeddedFile},
+				file:   true,
+			},
+			{ // embedded dir type
+				config: &ConfigPartial{"type": ConfigTypeEmbeddedDir},
+				dir:    true,
+			},
+		}
+
+		for _, scenario := range scenarios {
+			switch {
+			case fileCreator.Accept(scenario.config) != scenario.file:
+				t.Errorf("unexpected file acceptance of (%v)", scenario.config)
+			case dirCreator.Accept(scenario.config) != scenario.dir:
+				t.Errorf("unexpected dir acceptance of (%v)", scenario.config)
+			}
+		}
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		fileCreator, _ := NewConfigEmbeddedFileSourceCreator(container, parserFactory)
+		dirCreator, _ := NewConfigEmbeddedDirSourceCreator(container, parserFactory)
+
+		t.Run("error on nil config pointer", func(t *testing.T) {
+			if src, e := fileCreator.Create(nil); src != nil {
+				t.Error("returned a valid reference")
+			} else if !errors.Is(e, ErrNilPointer) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+			if src, e := dirCreator.Create(nil); src != nil {
+				t.Error("returned a valid reference")
+			} else if !errors.Is(e, ErrNilPointer) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("error on missing fs", func(t *testing.T) {
+			config := &ConfigPartial{"type": ConfigTypeEmbeddedFile, "path": "config.yaml"}
+			if src, e := fileCreator.Create(config); src != nil {
+				t.Error("returned a valid reference")
+			} else if !errors.Is(e, ErrInvalidConfigSupplier) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrInvalidConfigSupplier)
+			}
+		})
+
+		t.Run("error on unknown fs", func(t *testing.T) {
+			config := &ConfigPartial{"type": ConfigTypeEmbeddedFile, "fs": "unknown", "path": "config.yaml"}
+			if src, e := fileCreator.Create(config); src != nil {
+				t.Error("returned a valid reference")
+			} else if !errors.Is(e, ErrServiceNotFound) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrServiceNotFound)
+			}
+		})
+
+		t.Run("error on non fs service", func(t *testing.T) {
+			config := &ConfigPartial{"type": ConfigTypeEmbeddedFile, "fs": "invalid", "path": "config.yaml"}
+			if src, e := fileCreator.Create(config); src != nil {
+				t.Error("returned a valid reference")
+			} else if !errors.Is(e, ErrConversion) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrConversion)
+			}
+		})
+
+		t.Run("error on missing file", func(t *testing.T) {
+			config := &ConfigPartial{"type": ConfigTypeEmbeddedFile, "fs": "embedded", "path": "missing.yaml"}
+			if src, e := fileCreator.Create(config); src != nil {
+				t.Error("returned a valid reference")
+			} else if e == nil {
+				t.Error("didn't returned the expected error")
+			}
+		})
+
+		t.Run("create embedded file source", func(t *testing.T) {
+			config := &ConfigPartial{"type": ConfigTypeEmbeddedFile, "fs": "embedded", "path": "config.yaml"}
+			src, e := fileCreator.Create(config)
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case src == nil:
+				t.Error("didn't returned a valid reference")
+			default:
+				if check, _ := src.Get("field"); check != "value" {
+					t.Errorf("(%v) when expecting (value)", check)
+				}
+			}
+		})
+
+		t.Run("create embedded dir source", func(t *testing.T) {
+			config := &ConfigPartial{
+				"type":      ConfigTypeEmbeddedDir,
+				"fs":        "embedded",
+				"path":      "config",
+				"recursive": true,
+				"exclude":   "ignore.*",
+			}
+			src, e := dirCreator.Create(config)
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case src == nil:
+				t.Error("didn't returned a valid reference")
+			default:
+				expected := ConfigPartial{"base": "value", "extra": "value", "sub": "value"}
+				if check, _ := src.Get(""); !reflect.DeepEqual(check, expected) {
+					t.Errorf("(%v) when expecting (%v)", check, expected)
+				}
+			}
+		})
+
+		t.Run("create embedded dir source from the fs root", func(t *testing.T) {
+			config := &ConfigPartial{
+				"type":    ConfigTypeEmbeddedDir,
+				"fs":      "embedded",
+				"path":    ".",
+				"include": "*.yaml",
+			}
+			src, e := dirCreator.Create(config)
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case src == nil:
+				t.Error("didn't returned a valid reference")
+			default:
+				if check, _ := src.Get("field"); check != "value" {
+					t.Errorf("(%v) when expecting (value)", check)
+				}
+			}
+		})
+	})
+}
+
 func Test_ConfigRestSource(t *testing.T) {
 	t.Run("NewConfigRestSource", func(t *testing.T) {
 		t.Run("nil client", func(t *testing.T) {
@@ -4612,7 +5943,7 @@ func Test_ConfigRestSource(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(`{"path"`))
 			client := NewMockConfigRestRequester(ctrl)
 			client.EXPECT().Do(gomock.Any()).Return(&response, nil).Times(1)
@@ -4636,7 +5967,7 @@ func Test_ConfigRestSource(t *testing.T) {
 			defer ctrl.Finish()
 
 			expected := fmt.Errorf(`error message`)
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(`{"path"`))
 			client := NewMockConfigRestRequester(ctrl)
 			client.EXPECT().Do(gomock.Any()).Return(&response, nil).Times(1)
@@ -4663,7 +5994,7 @@ func Test_ConfigRestSource(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(`{"other_path": 123}`))
 			client := NewMockConfigRestRequester(ctrl)
 			client.EXPECT().Do(gomock.Any()).Return(&response, nil).Times(1)
@@ -4690,7 +6021,7 @@ func Test_ConfigRestSource(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(`{"path": 123}`))
 			client := NewMockConfigRestRequester(ctrl)
 			client.EXPECT().Do(gomock.Any()).Return(&response, nil).Times(1)
@@ -4717,7 +6048,7 @@ func Test_ConfigRestSource(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(`{"path": 123}`))
 			client := NewMockConfigRestRequester(ctrl)
 			client.EXPECT().Do(gomock.Any()).Return(&response, nil).Times(1)
@@ -4745,7 +6076,7 @@ func Test_ConfigRestSource(t *testing.T) {
 			defer ctrl.Finish()
 
 			expected := ConfigPartial{"field": "data"}
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(`{"path": {"field": "data"}}`))
 			client := NewMockConfigRestRequester(ctrl)
 			client.EXPECT().Do(gomock.Any()).Return(&response, nil).Times(1)
@@ -4773,7 +6104,7 @@ func Test_ConfigRestSource(t *testing.T) {
 			defer ctrl.Finish()
 
 			expected := ConfigPartial{"field": "data"}
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(`{"node": {"inner_node": {"field": "data"}}}`))
 			client := NewMockConfigRestRequester(ctrl)
 			client.EXPECT().Do(gomock.Any()).Return(&response, nil).Times(1)
@@ -4800,74 +6131,336 @@ func Test_ConfigRestSource(t *testing.T) {
 			}
 		})
 	})
-}
 
-func Test_ConfigRestSourceCreator(t *testing.T) {
-	t.Run("NewConfigRestSourceCreator", func(t *testing.T) {
-		t.Run("nil parser factory", func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
+	t.Run("request", func(t *testing.T) {
+		t.Run("non 2xx response status", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			}))
+			defer server.Close()
 
-			sut, e := NewConfigRestSourceCreator(nil)
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+
+			sut, e := NewConfigRestSource(server.Client(), server.URL, ConfigFormatJSON, parserFactory, "path")
 			switch {
 			case sut != nil:
 				t.Error("returned a valid reference")
 			case e == nil:
 				t.Error("didn't returned the expected error")
-			case !errors.Is(e, ErrNilPointer):
-				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			case !errors.Is(e, ErrInvalidConfigRestResponse):
+				t.Errorf("(%v) when expecting (%v)", e, ErrInvalidConfigRestResponse)
 			}
 		})
 
-		t.Run("new rest source factory creator", func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
+		t.Run("retry on server error", func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				calls++
+				if calls < 3 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				_, _ = w.Write([]byte(`{"path": {"field": "data"}}`))
+			}))
+			defer server.Close()
 
-			parserFactory := NewConfigParserFactory(nil)
+			expected := ConfigPartial{"field": "data"}
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+			options := ConfigRestRequest{Retry: ConfigRestRetry{Attempts: 2, Delay: time.Millisecond}}
 
-			sut, e := NewConfigRestSourceCreator(parserFactory)
+			sut, e := NewConfigRestSource(server.Client(), server.URL, ConfigFormatJSON, parserFactory, "path", options)
 			switch {
 			case e != nil:
 				t.Errorf("unexpected (%v) error", e)
-			case sut == nil:
-				t.Error("didn't returned a valid reference")
-			case sut.parserFactory != parserFactory:
-				t.Error("didn't stored the parser factory reference")
-			default:
-				client := sut.clientFactory()
-				switch client.(type) {
-				case *http.Client:
-				default:
-					t.Error("didn't stored a valid http client")
-				}
+			case calls != 3:
+				t.Errorf("(%v) calls when expecting 3", calls)
+			case !reflect.DeepEqual(sut.Partial, expected):
+				t.Error("didn't correctly stored the parsed partial")
 			}
 		})
-	})
 
-	t.Run("Accept", func(t *testing.T) {
-		t.Run("don't accept on invalid config pointer", func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
+		t.Run("don't retry on client error", func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				calls++
+				w.WriteHeader(http.StatusForbidden)
+			}))
+			defer server.Close()
 
-			sut, _ := NewConfigRestSourceCreator(NewConfigParserFactory(nil))
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+			options := ConfigRestRequest{Retry: ConfigRestRetry{Attempts: 2, Delay: time.Millisecond}}
 
-			if sut.Accept(nil) {
-				t.Error("returned true")
+			_, e := NewConfigRestSource(server.Client(), server.URL, ConfigFormatJSON, parserFactory, "path", options)
+			switch {
+			case !errors.Is(e, ErrInvalidConfigRestResponse):
+				t.Errorf("(%v) when expecting (%v)", e, ErrInvalidConfigRestResponse)
+			case calls != 1:
+				t.Errorf("(%v) calls when expecting 1", calls)
 			}
 		})
 
-		t.Run("don't accept if type is missing", func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
+		t.Run("don't expose the response body in the error", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"token": "secret"}`))
+			}))
+			defer server.Close()
 
-			sut, _ := NewConfigRestSourceCreator(NewConfigParserFactory(nil))
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
 
-			if sut.Accept(&ConfigPartial{}) {
-				t.Error("returned true")
+			_, e := NewConfigRestSource(server.Client(), server.URL, ConfigFormatJSON, parserFactory, "path")
+			switch {
+			case !errors.Is(e, ErrInvalidConfigRestResponse):
+				t.Errorf("(%v) when expecting (%v)", e, ErrInvalidConfigRestResponse)
+			case strings.Contains(fmt.Sprintf("%v", e.(*Error).Context()), "secret"):
+				t.Errorf("exposed the response body in the (%v) error context", e.(*Error).Context())
 			}
 		})
 
-		t.Run("don't accept if type is not a string", func(t *testing.T) {
+		t.Run("bound the retry delay", func(t *testing.T) {
+			prev := ConfigRestRetryMinDelay
+			ConfigRestRetryMinDelay = 50
+			defer func() { ConfigRestRetryMinDelay = prev }()
+
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				calls++
+				if calls < 2 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				_, _ = w.Write([]byte(`{"path": {"field": "data"}}`))
+			}))
+			defer server.Close()
+
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+			options := ConfigRestRequest{Retry: ConfigRestRetry{Attempts: 1}}
+
+			start := time.Now()
+			_, e := NewConfigRestSource(server.Client(), server.URL, ConfigFormatJSON, parserFactory, "path", options)
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case time.Since(start) < 50*time.Millisecond:
+				t.Errorf("retried after (%v) when expecting at least 50ms", time.Since(start))
+			}
+		})
+
+		t.Run("cancel the retry wait", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer server.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(50*time.Millisecond, cancel)
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+			options := ConfigRestRequest{Context: ctx, Retry: ConfigRestRetry{Attempts: 5, Delay: time.Minute}}
+
+			start := time.Now()
+			_, e := NewConfigRestSource(server.Client(), server.URL, ConfigFormatJSON, parserFactory, "path", options)
+			switch {
+			case !errors.Is(e, context.Canceled):
+				t.Errorf("(%v) when expecting (%v)", e, context.Canceled)
+			case time.Since(start) > 10*time.Second:
+				t.Errorf("waited (%v) after the cancellation", time.Since(start))
+			}
+		})
+
+		t.Run("send headers and bearer authentication", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("X-Header") != "value" || r.Header.Get("Authorization") != "Bearer token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				_, _ = w.Write([]byte(`{"path": {"field": "data"}}`))
+			}))
+			defer server.Close()
+
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+			options := ConfigRestRequest{
+				Headers: map[string]string{"X-Header": "value"},
+				Auth:    ConfigRestAuth{Type: ConfigRestAuthBearer, Token: "token"},
+			}
+
+			if _, e := NewConfigRestSource(server.Client(), server.URL, ConfigFormatJSON, parserFactory, "path", options); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			}
+		})
+
+		t.Run("send basic authentication", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if username, password, ok := r.BasicAuth(); !ok || username != "user" || password != "pass" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				_, _ = w.Write([]byte(`{"path": {"field": "data"}}`))
+			}))
+			defer server.Close()
+
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+			options := ConfigRestRequest{
+				Auth: ConfigRestAuth{Type: ConfigRestAuthBasic, Username: "user", Password: "pass"},
+			}
+
+			if _, e := NewConfigRestSource(server.Client(), server.URL, ConfigFormatJSON, parserFactory, "path", options); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			}
+		})
+
+		t.Run("send conditional request headers", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("If-None-Match") == `"v1"` {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				w.Header().Set("ETag", `"v1"`)
+				w.Header().Set("Last-Modified", "Wed, 15 Dec 2021 21:07:48 GMT")
+				_, _ = w.Write([]byte(`{"path": {"field": "data"}}`))
+			}))
+			defer server.Close()
+
+			expected := ConfigPartial{"field": "data"}
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+
+			sut, _ := NewConfigRestSource(server.Client(), server.URL, ConfigFormatJSON, parserFactory, "path")
+			e := sut.load()
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case sut.validators.etag != `"v1"`:
+				t.Errorf("stored (%v) etag", sut.validators.etag)
+			case sut.validators.lastModified != "Wed, 15 Dec 2021 21:07:48 GMT":
+				t.Errorf("stored (%v) last modified", sut.validators.lastModified)
+			case !reflect.DeepEqual(sut.Partial, expected):
+				t.Error("didn't kept the stored partial")
+			}
+		})
+
+		t.Run("don't store the validators of a not applied response", func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				switch requests {
+				case 1:
+					w.Header().Set("ETag", `"v1"`)
+					_, _ = w.Write([]byte(`{"path": {"field": "data"}}`))
+				case 2:
+					w.Header().Set("ETag", `"v2"`)
+					_, _ = w.Write([]byte(`{"other": {"field": "data"}}`))
+				default:
+					if r.Header.Get("If-None-Match") != `"v1"` {
+						t.Errorf("sent the (%v) etag", r.Header.Get("If-None-Match"))
+					}
+					w.Header().Set("ETag", `"v3"`)
+					_, _ = w.Write([]byte(`{"path": {"field": "updated"}}`))
+				}
+			}))
+			defer server.Close()
+
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+
+			sut, _ := NewConfigRestSource(server.Client(), server.URL, ConfigFormatJSON, parserFactory, "path")
+			if e := sut.load(); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if sut.validators.etag != `"v1"` {
+				t.Errorf("stored (%v) etag", sut.validators.etag)
+			}
+			e := sut.load()
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case sut.validators.etag != `"v3"`:
+				t.Errorf("stored (%v) etag", sut.validators.etag)
+			case !reflect.DeepEqual(sut.Partial, ConfigPartial{"field": "updated"}):
+				t.Errorf("stored the (%v) partial", sut.Partial)
+			}
+		})
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"path": {"field": "data"}}`))
+		}))
+		defer server.Close()
+
+		parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+		sut, _ := NewConfigRestSource(server.Client(), server.URL, ConfigFormatJSON, parserFactory, "path")
+
+		if e := sut.Close(); e != nil {
+			t.Errorf("unexpected (%v) error", e)
+		} else if e := sut.load(); !errors.Is(e, context.Canceled) {
+			t.Errorf("(%v) when expecting (%v)", e, context.Canceled)
+		}
+	})
+}
+
+func Test_ConfigRestSourceCreator(t *testing.T) {
+	t.Run("NewConfigRestSourceCreator", func(t *testing.T) {
+		t.Run("nil parser factory", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sut, e := NewConfigRestSourceCreator(nil)
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("new rest source factory creator", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			parserFactory := NewConfigParserFactory(nil)
+
+			sut, e := NewConfigRestSourceCreator(parserFactory)
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case sut == nil:
+				t.Error("didn't returned a valid reference")
+			case sut.parserFactory != parserFactory:
+				t.Error("didn't stored the parser factory reference")
+			default:
+				client := sut.clientFactory(0, nil)
+				switch client.(type) {
+				case *http.Client:
+				default:
+					t.Error("didn't stored a valid http client")
+				}
+			}
+		})
+	})
+
+	t.Run("Accept", func(t *testing.T) {
+		t.Run("don't accept on invalid config pointer", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sut, _ := NewConfigRestSourceCreator(NewConfigParserFactory(nil))
+
+			if sut.Accept(nil) {
+				t.Error("returned true")
+			}
+		})
+
+		t.Run("don't accept if type is missing", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sut, _ := NewConfigRestSourceCreator(NewConfigParserFactory(nil))
+
+			if sut.Accept(&ConfigPartial{}) {
+				t.Error("returned true")
+			}
+		})
+
+		t.Run("don't accept if type is not a string", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
@@ -5044,11 +6637,11 @@ func Test_ConfigRestSourceCreator(t *testing.T) {
 			parserFactory := NewConfigParserFactory([]ConfigParserCreator{parserCreator})
 
 			sut, _ := NewConfigRestSourceCreator(parserFactory)
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(`{"path": {"field": "value"}}`))
 			client := NewMockConfigRestRequester(ctrl)
 			client.EXPECT().Do(gomock.Any()).Return(&response, nil).Times(1)
-			sut.clientFactory = func() configRestRequester { return client }
+			sut.clientFactory = func(time.Duration, *tls.Config) configRestRequester { return client }
 
 			src, e := sut.Create(&ConfigPartial{
 				"uri":    uri,
@@ -5093,11 +6686,11 @@ func Test_ConfigRestSourceCreator(t *testing.T) {
 			parserFactory := NewConfigParserFactory([]ConfigParserCreator{parserCreator})
 
 			sut, _ := NewConfigRestSourceCreator(parserFactory)
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(`{"path": {"field": "value"}}`))
 			client := NewMockConfigRestRequester(ctrl)
 			client.EXPECT().Do(gomock.Any()).Return(&response, nil).Times(1)
-			sut.clientFactory = func() configRestRequester { return client }
+			sut.clientFactory = func(time.Duration, *tls.Config) configRestRequester { return client }
 
 			src, e := sut.Create(&ConfigPartial{
 				"uri": uri,
@@ -5121,6 +6714,189 @@ func Test_ConfigRestSourceCreator(t *testing.T) {
 				}
 			}
 		})
+
+		t.Run("create the rest source with the request options", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			_ = os.Setenv("SLATE_TEST_REST_TOKEN", "env token")
+			defer func() { _ = os.Unsetenv("SLATE_TEST_REST_TOKEN") }()
+
+			var timeout time.Duration
+			expected := ConfigRestRequest{
+				Headers: map[string]string{"x-header": "value"},
+				Auth:    ConfigRestAuth{Type: "bearer", Token: "env token"},
+				Retry:   ConfigRestRetry{Attempts: 3, Delay: 100 * time.Millisecond},
+			}
+			response := http.Response{StatusCode: http.StatusOK}
+			response.Body = io.NopCloser(strings.NewReader(`{"path": {"field": "value"}}`))
+			client := NewMockConfigRestRequester(ctrl)
+			client.EXPECT().Do(gomock.Any()).Return(&response, nil).Times(1)
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+			sut, _ := NewConfigRestSourceCreator(parserFactory)
+			sut.clientFactory = func(t time.Duration, _ *tls.Config) configRestRequester {
+				timeout = t
+				return client
+			}
+
+			src, e := sut.Create(&ConfigPartial{
+				"uri":     "uri",
+				"path":    ConfigPartial{"config": "path"},
+				"timeout": 5000,
+				"headers": ConfigPartial{"x-header": "value"},
+				"auth": ConfigPartial{
+					"type":  "bearer",
+					"token": "config token",
+					"env":   ConfigPartial{"token": "SLATE_TEST_REST_TOKEN"},
+				},
+				"retry": ConfigPartial{"attempts": 3, "delay": 100},
+			})
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case timeout != 5*time.Second:
+				t.Errorf("(%v) timeout when expecting 5s", timeout)
+			case !reflect.DeepEqual(src.(*ConfigRestSource).options, expected):
+				t.Errorf("(%v) options when expecting (%v)", src.(*ConfigRestSource).options, expected)
+			}
+		})
+
+		t.Run("error on invalid header value", func(t *testing.T) {
+			parserFactory := NewConfigParserFactory(nil)
+			sut, _ := NewConfigRestSourceCreator(parserFactory)
+
+			src, e := sut.Create(&ConfigPartial{
+				"uri":     "uri",
+				"path":    ConfigPartial{"config": "path"},
+				"headers": ConfigPartial{"x-header": 123},
+			})
+			switch {
+			case src != nil:
+				t.Error("returned a valid reference")
+			case !errors.Is(e, ErrConversion):
+				t.Errorf("(%v) when expecting (%v)", e, ErrConversion)
+			}
+		})
+
+		t.Run("error on missing TLS CA file", func(t *testing.T) {
+			parserFactory := NewConfigParserFactory(nil)
+			sut, _ := NewConfigRestSourceCreator(parserFactory)
+
+			src, e := sut.Create(&ConfigPartial{
+				"uri":  "uri",
+				"path": ConfigPartial{"config": "path"},
+				"tls":  ConfigPartial{"ca": "/invalid/ca.pem"},
+			})
+			switch {
+			case src != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			}
+		})
+
+		t.Run("error on TLS client key without cert", func(t *testing.T) {
+			parserFactory := NewConfigParserFactory(nil)
+			sut, _ := NewConfigRestSourceCreator(parserFactory)
+
+			src, e := sut.Create(&ConfigPartial{
+				"uri":  "uri",
+				"path": ConfigPartial{"config": "path"},
+				"tls":  ConfigPartial{"key": "/path/key.pem"},
+			})
+			switch {
+			case src != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrInvalidConfigSupplier):
+				t.Errorf("(%v) when expecting (%v)", e, ErrInvalidConfigSupplier)
+			}
+		})
+
+		t.Run("error on TLS client cert without key", func(t *testing.T) {
+			parserFactory := NewConfigParserFactory(nil)
+			sut, _ := NewConfigRestSourceCreator(parserFactory)
+
+			src, e := sut.Create(&ConfigPartial{
+				"uri":  "uri",
+				"path": ConfigPartial{"config": "path"},
+				"tls":  ConfigPartial{"cert": "/path/cert.pem"},
+			})
+			switch {
+			case src != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrInvalidConfigSupplier):
+				t.Errorf("(%v) when expecting (%v)", e, ErrInvalidConfigSupplier)
+			}
+		})
+
+		t.Run("read the TLS files from the file system", func(t *testing.T) {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"path": {"field": "value"}}`))
+			}))
+			defer server.Close()
+
+			fileSystem := afero.NewMemMapFs()
+			ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+			_ = afero.WriteFile(fileSystem, "/tls/ca.pem", ca, 0o644)
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+			sut, _ := NewConfigRestSourceCreator(parserFactory, fileSystem)
+
+			src, e := sut.Create(&ConfigPartial{
+				"uri":  server.URL,
+				"path": ConfigPartial{"config": "path"},
+				"tls":  ConfigPartial{"ca": "/tls/ca.pem"},
+			})
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(src.(*ConfigRestSource).Partial, ConfigPartial{"field": "value"}):
+				t.Error("didn't loaded the content correctly")
+			}
+		})
+
+		t.Run("error on TLS client key file missing from the file system", func(t *testing.T) {
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "/tls/cert.pem", []byte("cert"), 0o644)
+			sut, _ := NewConfigRestSourceCreator(NewConfigParserFactory(nil), fileSystem)
+
+			src, e := sut.Create(&ConfigPartial{
+				"uri":  "uri",
+				"path": ConfigPartial{"config": "path"},
+				"tls":  ConfigPartial{"cert": "/tls/cert.pem", "key": "/tls/key.pem"},
+			})
+			switch {
+			case src != nil:
+				t.Error("returned a valid reference")
+			case !errors.Is(e, os.ErrNotExist):
+				t.Errorf("(%v) when expecting (%v)", e, os.ErrNotExist)
+			}
+		})
+
+		t.Run("create the client with the TLS configuration", func(t *testing.T) {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"path": {"field": "value"}}`))
+			}))
+			defer server.Close()
+
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+			sut, _ := NewConfigRestSourceCreator(parserFactory)
+
+			src, e := sut.Create(&ConfigPartial{
+				"uri":  server.URL,
+				"path": ConfigPartial{"config": "path"},
+				"tls":  ConfigPartial{"insecure": true},
+			})
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(src.(*ConfigRestSource).Partial, ConfigPartial{"field": "value"}):
+				t.Error("didn't loaded the content correctly")
+			}
+		})
 	})
 }
 
@@ -5223,7 +6999,7 @@ func Test_ConfigObsRestSource(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(`{"path"`))
 			client := NewMockConfigRestRequester(ctrl)
 			client.EXPECT().Do(gomock.Any()).Return(&response, nil).Times(1)
@@ -5251,7 +7027,7 @@ func Test_ConfigObsRestSource(t *testing.T) {
 			defer ctrl.Finish()
 
 			expected := fmt.Errorf(`error message`)
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(`{"path"`))
 			client := NewMockConfigRestRequester(ctrl)
 			client.EXPECT().Do(gomock.Any()).Return(&response, nil).Times(1)
@@ -5284,7 +7060,7 @@ func Test_ConfigObsRestSource(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(`{"other_path": 123}`))
 			client := NewMockConfigRestRequester(ctrl)
 			client.EXPECT().Do(gomock.Any()).Return(&response, nil).Times(1)
@@ -5317,7 +7093,7 @@ func Test_ConfigObsRestSource(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(`{"timestamp": 123}`))
 			client := NewMockConfigRestRequester(ctrl)
 			client.EXPECT().Do(gomock.Any()).Return(&response, nil).Times(1)
@@ -5351,7 +7127,7 @@ func Test_ConfigObsRestSource(t *testing.T) {
 			defer ctrl.Finish()
 
 			expected := "parsing time \"abc\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"abc\" as \"2006\""
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(`{"timestamp": "abc"}`))
 			client := NewMockConfigRestRequester(ctrl)
 			client.EXPECT().Do(gomock.Any()).Return(&response, nil).Times(1)
@@ -5384,7 +7160,7 @@ func Test_ConfigObsRestSource(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(
 				`{"timestamp": "2000-01-01T00:00:00Z", other_path": 123}`,
 			))
@@ -5421,7 +7197,7 @@ func Test_ConfigObsRestSource(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(
 				`{"timestamp": "2000-01-01T00:00:00Z", "path": 123}`,
 			))
@@ -5459,7 +7235,7 @@ func Test_ConfigObsRestSource(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(
 				`{"timestamp": "2000-01-01T00:00:00Z", "path": 123}`,
 			))
@@ -5498,7 +7274,7 @@ func Test_ConfigObsRestSource(t *testing.T) {
 			defer ctrl.Finish()
 
 			expected := ConfigPartial{"field": "data"}
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(
 				`{"timestamp": "2000-01-01T00:00:00Z", "path": {"field": "data"}}`,
 			))
@@ -5537,7 +7313,7 @@ func Test_ConfigObsRestSource(t *testing.T) {
 			defer ctrl.Finish()
 
 			expected := ConfigPartial{"field": "data"}
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(
 				`{"timestamp": "2000-01-01T00:00:00Z", "node": {"inner_node": {"field": "data"}}}`,
 			))
@@ -5580,11 +7356,11 @@ func Test_ConfigObsRestSource(t *testing.T) {
 			defer ctrl.Finish()
 
 			expected := ConfigPartial{"field": "data 1"}
-			response1 := http.Response{}
+			response1 := http.Response{StatusCode: http.StatusOK}
 			response1.Body = io.NopCloser(strings.NewReader(
 				`{"node": {"field": "data 1"}, "timestamp": "2021-12-15T21:07:48.239Z"}`,
 			))
-			response2 := http.Response{}
+			response2 := http.Response{StatusCode: http.StatusOK}
 			response2.Body = io.NopCloser(strings.NewReader(
 				`{"node": {"field": "data 2"}, "timestamp": "2021-12-15T21:07:48.239Z"}`,
 			))
@@ -5642,11 +7418,11 @@ func Test_ConfigObsRestSource(t *testing.T) {
 			defer ctrl.Finish()
 
 			expected := ConfigPartial{"field": "data 2"}
-			response1 := http.Response{}
+			response1 := http.Response{StatusCode: http.StatusOK}
 			response1.Body = io.NopCloser(strings.NewReader(
 				`{"node": {"field": "data 1"}, "timestamp": "2021-12-15T21:07:48.239Z"}`,
 			))
-			response2 := http.Response{}
+			response2 := http.Response{StatusCode: http.StatusOK}
 			response2.Body = io.NopCloser(strings.NewReader(
 				`{"node": {"field": "data 2"}, "timestamp": "2021-12-15T21:07:48.240Z"}`,
 			))
@@ -5698,23 +7474,218 @@ func Test_ConfigObsRestSource(t *testing.T) {
 				t.Error("didn't correctly stored the parsed partial")
 			}
 		})
-	})
-}
 
-func Test_ConfigObsRestSourceCreator(t *testing.T) {
-	t.Run("NewConfigObsRestSourceCreator", func(t *testing.T) {
-		t.Run("nil parser factory", func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
+		t.Run("don't reload on not modified response", func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if r.Header.Get("If-None-Match") == `"v1"` {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				w.Header().Set("ETag", `"v1"`)
+				_, _ = w.Write([]byte(`{"node": {"field": "data"}, "timestamp": "2021-12-15T21:07:48.239Z"}`))
+			}))
+			defer server.Close()
 
-			sut, e := NewConfigObsRestSourceCreator(nil)
+			expected := ConfigPartial{"field": "data"}
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+
+			sut, _ := NewConfigObsRestSource(server.Client(), server.URL, ConfigFormatJSON, parserFactory, "timestamp", "node")
+
+			loaded, e := sut.Reload()
 			switch {
-			case sut != nil:
-				t.Error("returned a valid reference")
-			case e == nil:
-				t.Error("didn't returned the expected error")
-			case !errors.Is(e, ErrNilPointer):
-				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			case loaded != false:
+				t.Error("unexpectedly reload the source config")
+			case e != nil:
+				t.Errorf("returned the unexpected e : %v", e)
+			case calls != 2:
+				t.Errorf("(%v) calls when expecting 2", calls)
+			case !reflect.DeepEqual(sut.Partial, expected):
+				t.Error("didn't kept the stored partial")
+			}
+		})
+
+		t.Run("don't store the validators of a not applied revision", func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				switch {
+				case r.Header.Get("If-None-Match") == `"v2"`:
+					w.WriteHeader(http.StatusNotModified)
+				case calls == 1:
+					w.Header().Set("ETag", `"v1"`)
+					_, _ = w.Write([]byte(`{"node": {"field": "data"}, "timestamp": "2021-12-15T21:07:48.239Z"}`))
+				default:
+					w.Header().Set("ETag", `"v2"`)
+					_, _ = w.Write([]byte(`{"node": {"field": "updated"}, "timestamp": "invalid"}`))
+				}
+			}))
+			defer server.Close()
+
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+
+			sut, _ := NewConfigObsRestSource(server.Client(), server.URL, ConfigFormatJSON, parserFactory, "timestamp", "node")
+
+			_, e1 := sut.Reload()
+			_, e2 := sut.Reload()
+			switch {
+			case e1 == nil || e2 == nil:
+				t.Error("didn't returned the expected error")
+			case calls != 3:
+				t.Errorf("(%v) calls when expecting 3", calls)
+			case sut.validators.etag != `"v1"`:
+				t.Errorf("stored (%v) etag", sut.validators.etag)
+			case !reflect.DeepEqual(sut.Partial, ConfigPartial{"field": "data"}):
+				t.Errorf("stored the (%v) partial", sut.Partial)
+			}
+		})
+	})
+
+	t.Run("change detection strategies", func(t *testing.T) {
+		t.Run("invalid strategy", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			client := NewMockConfigRestRequester(ctrl)
+			parserFactory := NewConfigParserFactory(nil)
+
+			sut, e := NewConfigObsRestSource(client, "uri", ConfigFormatJSON, parserFactory, "timestamp", "node", ConfigObsRestOptions{Strategy: "invalid"})
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case !errors.Is(e, ErrInvalidConfigRestTimestamp):
+				t.Errorf("(%v) when expecting (%v)", e, ErrInvalidConfigRestTimestamp)
+			}
+		})
+
+		t.Run("default strategy", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"node": {"field": 1}, "ts": "2021-12-15T21:07:48.239Z"}`))
+			}))
+			defer server.Close()
+
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+
+			sut, e := NewConfigObsRestSource(server.Client(), server.URL, ConfigFormatJSON, parserFactory, "ts", "node", ConfigObsRestOptions{})
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case sut.strategy != ConfigDefaultRestTimestamp:
+				t.Errorf("stored the (%v) strategy", sut.strategy)
+			}
+		})
+
+		scenarios := []struct {
+			name      string
+			strategy  string
+			responses []string
+			expected  []bool
+		}{
+			{ // epoch seconds
+				name:     "epoch",
+				strategy: ConfigRestTimestampEpoch,
+				responses: []string{
+					`{"node": {"field": 1}, "ts": 1639602468}`,
+					`{"node": {"field": 2}, "ts": 1639602468}`,
+					`{"node": {"field": 3}, "ts": "1639602469"}`,
+				},
+				expected: []bool{false, true},
+			},
+			{ // epoch milliseconds
+				name:     "epoch milliseconds",
+				strategy: ConfigRestTimestampEpochMillis,
+				responses: []string{
+					`{"node": {"field": 1}, "ts": 1639602468000}`,
+					`{"node": {"field": 2}, "ts": 1639602468000}`,
+					`{"node": {"field": 3}, "ts": 1639602468001}`,
+				},
+				expected: []bool{false, true},
+			},
+			{ // version counter
+				name:     "version",
+				strategy: ConfigRestTimestampVersion,
+				responses: []string{
+					`{"node": {"field": 1}, "ts": 2}`,
+					`{"node": {"field": 2}, "ts": 1}`,
+					`{"node": {"field": 3}, "ts": 3}`,
+				},
+				expected: []bool{false, true},
+			},
+			{ // section content hash
+				name:     "hash",
+				strategy: ConfigRestTimestampHash,
+				responses: []string{
+					`{"node": {"field": 1, "other": [1, 2]}}`,
+					`{"node": {"other": [1, 2], "field": 1}}`,
+					`{"node": {"field": 1, "other": [2, 1]}}`,
+				},
+				expected: []bool{false, true},
+			},
+		}
+
+		for _, scenario := range scenarios {
+			t.Run(scenario.name, func(t *testing.T) {
+				call := 0
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					_, _ = w.Write([]byte(scenario.responses[call]))
+					call++
+				}))
+				defer server.Close()
+
+				parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+
+				sut, e := NewConfigObsRestSource(server.Client(), server.URL, ConfigFormatJSON, parserFactory, "ts", "node", ConfigObsRestOptions{Strategy: scenario.strategy})
+				if e != nil {
+					t.Errorf("unexpected (%v) error", e)
+					return
+				}
+				for i, expected := range scenario.expected {
+					if loaded, e := sut.Reload(); e != nil {
+						t.Errorf("unexpected (%v) error", e)
+					} else if loaded != expected {
+						t.Errorf("(%v) reload (%d) when expecting (%v)", loaded, i, expected)
+					}
+				}
+				if v, _ := sut.Get("field"); v != 3 && scenario.strategy != ConfigRestTimestampHash {
+					t.Errorf("(%v) when expecting (3)", v)
+				}
+			})
+		}
+
+		t.Run("invalid epoch value", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"node": {"field": 1}, "ts": true}`))
+			}))
+			defer server.Close()
+
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+
+			sut, e := NewConfigObsRestSource(server.Client(), server.URL, ConfigFormatJSON, parserFactory, "ts", "node", ConfigObsRestOptions{Strategy: ConfigRestTimestampEpoch})
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case !errors.Is(e, ErrConversion):
+				t.Errorf("(%v) when expecting (%v)", e, ErrConversion)
+			}
+		})
+	})
+}
+
+func Test_ConfigObsRestSourceCreator(t *testing.T) {
+	t.Run("NewConfigObsRestSourceCreator", func(t *testing.T) {
+		t.Run("nil parser factory", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sut, e := NewConfigObsRestSourceCreator(nil)
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
 			}
 		})
 
@@ -5733,7 +7704,7 @@ func Test_ConfigObsRestSourceCreator(t *testing.T) {
 			case sut.parserFactory != parserFactory:
 				t.Error("didn't stored the parser factory reference")
 			default:
-				client := sut.clientFactory()
+				client := sut.clientFactory(0, nil)
 				switch client.(type) {
 				case *http.Client:
 				default:
@@ -5985,13 +7956,13 @@ func Test_ConfigObsRestSourceCreator(t *testing.T) {
 			parserFactory := NewConfigParserFactory([]ConfigParserCreator{parserCreator})
 
 			sut, _ := NewConfigObsRestSourceCreator(parserFactory)
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(
 				`{"path": {"field": "value"}, "timestamp": "2021-12-15T21:07:48.239Z"}`,
 			))
 			client := NewMockConfigRestRequester(ctrl)
 			client.EXPECT().Do(gomock.Any()).Return(&response, nil).Times(1)
-			sut.clientFactory = func() configRestRequester { return client }
+			sut.clientFactory = func(time.Duration, *tls.Config) configRestRequester { return client }
 
 			src, e := sut.Create(&ConfigPartial{
 				"uri":    uri,
@@ -6041,13 +8012,13 @@ func Test_ConfigObsRestSourceCreator(t *testing.T) {
 			parserFactory := NewConfigParserFactory([]ConfigParserCreator{parserCreator})
 
 			sut, _ := NewConfigObsRestSourceCreator(parserFactory)
-			response := http.Response{}
+			response := http.Response{StatusCode: http.StatusOK}
 			response.Body = io.NopCloser(strings.NewReader(
 				`{"path": {"field": "value"}, "timestamp": "2021-12-15T21:07:48.239Z"}`,
 			))
 			client := NewMockConfigRestRequester(ctrl)
 			client.EXPECT().Do(gomock.Any()).Return(&response, nil).Times(1)
-			sut.clientFactory = func() configRestRequester { return client }
+			sut.clientFactory = func(time.Duration, *tls.Config) configRestRequester { return client }
 
 			src, e := sut.Create(&ConfigPartial{
 				"uri": uri,
@@ -6072,943 +8043,3483 @@ func Test_ConfigObsRestSourceCreator(t *testing.T) {
 				}
 			}
 		})
-	})
-}
 
-func Test_Config(t *testing.T) {
-	t.Run("NewConfig", func(t *testing.T) {
-		t.Run("new config without reload", func(t *testing.T) {
-			ConfigObserveFrequency = 0
-			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
+		t.Run("create the observable rest source with the hash strategy", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"path": {"field": "value"}}`))
+			}))
+			defer server.Close()
+
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+			sut, _ := NewConfigObsRestSourceCreator(parserFactory)
 
+			src, e := sut.Create(&ConfigPartial{
+				"uri":      server.URL,
+				"strategy": ConfigRestTimestampHash,
+				"path":     ConfigPartial{"config": "path"},
+			})
 			switch {
-			case sut.mutex == nil:
-				t.Error("didn't instantiate the access mutex")
-			case sut.suppliers == nil:
-				t.Error("didn't instantiate the suppliers storing array")
-			case sut.observers == nil:
-				t.Error("didn't instantiate the observers storing array")
-			case sut.observer != nil:
-				t.Error("instantiated the suppliers reload trigger")
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case src.(*ConfigObsRestSource).strategy != ConfigRestTimestampHash:
+				t.Error("didn't stored the change detection strategy")
+			case !reflect.DeepEqual(src.(*ConfigObsRestSource).Partial, ConfigPartial{"field": "value"}):
+				t.Error("didn't loaded the content correctly")
 			}
 		})
+	})
+}
 
-		t.Run("new config with reload", func(t *testing.T) {
-			ConfigObserveFrequency = 10
-			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
+func Test_ConfigCommandSourceProcess(t *testing.T) {
+	// helper process executed by the command source tests
+	if os.Getenv("SLATE_TEST_COMMAND_PROCESS") != "1" {
+		return
+	}
+	args := os.Args
+	for len(args) > 0 && args[0] != "--" {
+		args = args[1:]
+	}
+	switch args[1] {
+	case "cat":
+		content, _ := os.ReadFile(args[2])
+		_, _ = os.Stdout.Write(content)
+		os.Exit(0)
+	case "sleep":
+		time.Sleep(10 * time.Second)
+		os.Exit(0)
+	default:
+		_, _ = fmt.Fprint(os.Stderr, "helper failure\n")
+		os.Exit(2)
+	}
+}
 
+func Test_ConfigCommandSource(t *testing.T) {
+	t.Setenv("SLATE_TEST_COMMAND_PROCESS", "1")
+	parserFactory := NewConfigParserFactory([]ConfigParserCreator{
+		NewConfigYAMLDecoderCreator(),
+		NewConfigJSONDecoderCreator(),
+	})
+	args := func(mode ...string) []string {
+		return append([]string{"-test.run=^Test_ConfigCommandSourceProcess$", "--"}, mode...)
+	}
+	output := func(content string) string {
+		path := t.TempDir() + "/output.json"
+		_ = os.WriteFile(path, []byte(content), 0o644)
+		return path
+	}
+
+	t.Run("NewConfigCommandSource", func(t *testing.T) {
+		t.Run("nil parser factory", func(t *testing.T) {
+			sut, e := NewConfigCommandSource(os.Args[0], args("cat", "path"), 0, ConfigFormatJSON, nil)
 			switch {
-			case sut.mutex == nil:
-				t.Error("didn't instantiate the access mutex")
-			case sut.suppliers == nil:
-				t.Error("didn't instantiate the suppliers storing array")
-			case sut.observers == nil:
-				t.Error("didn't instantiate the observers storing array")
-			case sut.observer == nil:
-				t.Error("didn't instantiate the suppliers reload trigger")
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
 			}
 		})
-	})
-
-	t.Run("Close", func(t *testing.T) {
-		t.Run("error while closing supplier", func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			expected := fmt.Errorf("error message")
 
-			ConfigObserveFrequency = 0
-			sut := NewConfig()
-			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).AnyTimes()
-			supplier.EXPECT().Close().Return(expected).Times(1)
-			_ = sut.AddSupplier("supplier", 0, supplier)
-
-			if e := sut.Close(); e == nil {
+		t.Run("error on failing command with the captured stderr", func(t *testing.T) {
+			sut, e := NewConfigCommandSource(os.Args[0], args("fail"), 0, ConfigFormatJSON, parserFactory)
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
 				t.Error("didn't returned the expected error")
-			} else if e.Error() != expected.Error() {
-				t.Errorf("(%v) when expecting (%v)", e, expected)
+			case !errors.Is(e, ErrConfigCommandFailed):
+				t.Errorf("(%v) when expecting (%v)", e, ErrConfigCommandFailed)
+			case !strings.Contains(e.Error(), "helper failure"):
+				t.Errorf("(%v) error without the command stderr", e)
 			}
 		})
-		t.Run("error while closing observer", func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
 
-			expected := fmt.Errorf("error message")
-			ConfigObserveFrequency = 0
-			sut := NewConfig()
-			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).AnyTimes()
-			supplier.EXPECT().Close().Return(nil).Times(1)
-			_ = sut.AddSupplier("supplier", 0, supplier)
-			observer := NewMockTrigger(ctrl)
-			observer.EXPECT().Close().Return(expected).Times(1)
-			sut.observer = observer
+		t.Run("error on unknown command", func(t *testing.T) {
+			if _, e := NewConfigCommandSource("slate-unknown-command", nil, 0, ConfigFormatJSON, parserFactory); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrConfigCommandFailed) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrConfigCommandFailed)
+			}
+		})
 
-			if e := sut.Close(); e == nil {
+		t.Run("error on command timeout", func(t *testing.T) {
+			_, e := NewConfigCommandSource(os.Args[0], args("sleep"), 100*time.Millisecond, ConfigFormatJSON, parserFactory)
+			switch {
+			case e == nil:
 				t.Error("didn't returned the expected error")
-			} else if e.Error() != expected.Error() {
-				t.Errorf("(%v) when expecting (%v)", e, expected)
+			case !errors.Is(e, ErrConfigCommandFailed):
+				t.Errorf("(%v) when expecting (%v)", e, ErrConfigCommandFailed)
+			default:
+				var err *Error
+				if !errors.As(e, &err) || err.Context()["description"] != "timeout" {
+					t.Errorf("(%v) error without the timeout description", e)
+				}
 			}
 		})
 
-		t.Run("propagate close to suppliers", func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
+		t.Run("error on unrecognized format", func(t *testing.T) {
+			if _, e := NewConfigCommandSource(os.Args[0], args("cat", output("{}")), 0, "unknown", parserFactory); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrInvalidConfigFormat) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrInvalidConfigFormat)
+			}
+		})
 
-			ConfigObserveFrequency = 0
-			sut := NewConfig()
-			id1 := "supplier.1"
-			id2 := "supplier.2"
-			priority1 := 0
-			priority2 := 1
-			supplier1 := NewMockConfigSupplier(ctrl)
-			supplier1.EXPECT().Get("").Return(ConfigPartial{}, nil).AnyTimes()
-			supplier1.EXPECT().Close().Times(1)
-			supplier2 := NewMockConfigSupplier(ctrl)
-			supplier2.EXPECT().Get("").Return(ConfigPartial{}, nil).AnyTimes()
-			supplier2.EXPECT().Close().Times(1)
-			_ = sut.AddSupplier(id1, priority1, supplier1)
-			_ = sut.AddSupplier(id2, priority2, supplier2)
+		t.Run("error on invalid output", func(t *testing.T) {
+			if _, e := NewConfigCommandSource(os.Args[0], args("cat", output("{")), 0, ConfigFormatJSON, parserFactory); e == nil {
+				t.Error("didn't returned the expected error")
+			}
+		})
 
-			_ = sut.Close()
+		t.Run("load the command output", func(t *testing.T) {
+			sut, e := NewConfigCommandSource(os.Args[0], args("cat", output(`{"node": "value"}`)), time.Minute, ConfigFormatJSON, parserFactory)
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(sut.Partial, ConfigPartial{"node": "value"}):
+				t.Errorf("stored the (%v) partial", sut.Partial)
+			}
 		})
 	})
 
-	t.Run("Entries", func(t *testing.T) {
-		t.Run("return partial entries", func(t *testing.T) {
-			scenarios := []struct {
-				config   ConfigPartial
-				expected []string
-			}{
-				{ // _test the empty partial
-					config:   ConfigPartial{},
-					expected: nil,
-				},
-				{ // _test the single entry partial
-					config:   ConfigPartial{"field": "value"},
-					expected: []string{"field"},
-				},
-				{ // _test the multi entry partial
-					config:   ConfigPartial{"field1": "value 1", "field2": "value 2"},
-					expected: []string{"field1", "field2"},
-				},
-			}
+	t.Run("Reload", func(t *testing.T) {
+		t.Run("don't reload on unchanged output", func(t *testing.T) {
+			sut, _ := NewConfigCommandSource(os.Args[0], args("cat", output(`{"node": "value"}`)), 0, ConfigFormatJSON, parserFactory)
 
-			for _, s := range scenarios {
-				test := func() {
-					ctrl := gomock.NewController(t)
+			if reloaded, e := sut.Reload(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if reloaded {
+				t.Error("unexpectedly reloaded the supplier")
+			}
+		})
 
-					ConfigObserveFrequency = 0
-					sut := NewConfig()
-					supplier := NewMockConfigSupplier(ctrl)
-					supplier.EXPECT().Close().Times(1)
-					supplier.EXPECT().Get("").Return(s.config, nil).Times(1)
-					_ = sut.AddSupplier("supplier", 0, supplier)
+		t.Run("reload on changed output", func(t *testing.T) {
+			path := output(`{"node": "value1"}`)
+			sut, _ := NewConfigCommandSource(os.Args[0], args("cat", path), 0, ConfigFormatJSON, parserFactory)
+			_ = os.WriteFile(path, []byte(`{"node": "value2"}`), 0o644)
 
-					defer func() { _ = sut.Close(); ctrl.Finish() }()
+			reloaded, e := sut.Reload()
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reloaded:
+				t.Error("didn't reloaded the supplier")
+			case !reflect.DeepEqual(sut.Partial, ConfigPartial{"node": "value2"}):
+				t.Errorf("stored the (%v) partial", sut.Partial)
+			}
+		})
 
-					check := sut.Entries()
+		t.Run("keep the content on reload error", func(t *testing.T) {
+			path := output(`{"node": "value"}`)
+			sut, _ := NewConfigCommandSource(os.Args[0], args("cat", path), 0, ConfigFormatJSON, parserFactory)
+			_ = os.WriteFile(path, []byte(`{`), 0o644)
 
-					sort.Strings(s.expected)
-					sort.Strings(check)
-					if !reflect.DeepEqual(check, s.expected) {
-						t.Errorf("(%v) when expecting (%v)", check, s.expected)
-					}
-				}
-				test()
+			reloaded, e := sut.Reload()
+			switch {
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case reloaded:
+				t.Error("unexpectedly reloaded the supplier")
+			case !reflect.DeepEqual(sut.Partial, ConfigPartial{"node": "value"}):
+				t.Errorf("stored the (%v) partial", sut.Partial)
 			}
 		})
 	})
+}
 
-	t.Run("Has", func(t *testing.T) {
-		t.Run("return the existence of the path", func(t *testing.T) {
-			scenarios := []struct {
-				config   ConfigPartial
-				search   string
-				expected bool
-			}{
+func Test_ConfigCommandSourceCreator(t *testing.T) {
+	t.Setenv("SLATE_TEST_COMMAND_PROCESS", "1")
+	parserFactory := NewConfigParserFactory([]ConfigParserCreator{
+		NewConfigYAMLDecoderCreator(),
+		NewConfigJSONDecoderCreator(),
+	})
+
+	t.Run("NewConfigCommandSourceCreator", func(t *testing.T) {
+		t.Run("nil parser factory", func(t *testing.T) {
+			sut, e := NewConfigCommandSourceCreator(nil)
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("new command source factory creator", func(t *testing.T) {
+			sut, e := NewConfigCommandSourceCreator(parserFactory)
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case sut == nil:
+				t.Error("didn't returned a valid reference")
+			case sut.parserFactory != parserFactory:
+				t.Error("didn't stored the parser factory reference")
+			}
+		})
+	})
+
+	t.Run("Accept", func(t *testing.T) {
+		sut, _ := NewConfigCommandSourceCreator(parserFactory)
+
+		t.Run("don't accept on invalid config pointer", func(t *testing.T) {
+			if sut.Accept(nil) {
+				t.Error("returned true")
+			}
+		})
+
+		t.Run("don't accept if type is missing", func(t *testing.T) {
+			if sut.Accept(&ConfigPartial{}) {
+				t.Error("returned true")
+			}
+		})
+
+		t.Run("don't accept if type is not a string", func(t *testing.T) {
+			if sut.Accept(&ConfigPartial{"type": 123}) {
+				t.Error("returned true")
+			}
+		})
+
+		t.Run("don't accept if invalid type", func(t *testing.T) {
+			if sut.Accept(&ConfigPartial{"type": ConfigTypeFile}) {
+				t.Error("returned true")
+			}
+		})
+
+		t.Run("accept config", func(t *testing.T) {
+			if !sut.Accept(&ConfigPartial{"type": ConfigTypeCommand}) {
+				t.Error("returned false")
+			}
+		})
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		sut, _ := NewConfigCommandSourceCreator(parserFactory)
+
+		t.Run("error on nil config pointer", func(t *testing.T) {
+			src, e := sut.Create(nil)
+			switch {
+			case src != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("missing command", func(t *testing.T) {
+			src, e := sut.Create(&ConfigPartial{"type": ConfigTypeCommand})
+			switch {
+			case src != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrInvalidConfigSupplier):
+				t.Errorf("(%v) when expecting (%v)", e, ErrInvalidConfigSupplier)
+			}
+		})
+
+		t.Run("non-list args", func(t *testing.T) {
+			src, e := sut.Create(&ConfigPartial{"type": ConfigTypeCommand, "command": os.Args[0], "args": "arg"})
+			switch {
+			case src != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrConversion):
+				t.Errorf("(%v) when expecting (%v)", e, ErrConversion)
+			}
+		})
+
+		t.Run("non-scalar arg", func(t *testing.T) {
+			src, e := sut.Create(&ConfigPartial{"type": ConfigTypeCommand, "command": os.Args[0], "args": []interface{}{ConfigPartial{}}})
+			switch {
+			case src != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrConversion):
+				t.Errorf("(%v) when expecting (%v)", e, ErrConversion)
+			}
+		})
+
+		t.Run("create the command source", func(t *testing.T) {
+			path := t.TempDir() + "/output.yaml"
+			_ = os.WriteFile(path, []byte("node: value"), 0o644)
+
+			src, e := sut.Create(&ConfigPartial{
+				"type":    ConfigTypeCommand,
+				"command": os.Args[0],
+				"args":    []interface{}{"-test.run=^Test_ConfigCommandSourceProcess$", "--", "cat", path},
+				"timeout": 5000,
+				"format":  ConfigFormatYAML,
+			})
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case src == nil:
+				t.Error("didn't returned a valid reference")
+			default:
+				switch s := src.(type) {
+				case *ConfigCommandSource:
+					switch {
+					case s.timeout != 5*time.Second:
+						t.Errorf("stored the (%v) timeout", s.timeout)
+					case !reflect.DeepEqual(s.Partial, ConfigPartial{"node": "value"}):
+						t.Errorf("stored the (%v) partial", s.Partial)
+					}
+				default:
+					t.Error("didn't returned a command source")
+				}
+			}
+		})
+	})
+}
+
+func Test_ConfigOverrideSource(t *testing.T) {
+	parserFactory := NewConfigParserFactory([]ConfigParserCreator{
+		NewConfigYAMLDecoderCreator(),
+		NewConfigJSONDecoderCreator(),
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		t.Run("store the converted value", func(t *testing.T) {
+			sut := NewConfigOverrideSource()
+
+			if e := sut.Set("node.sub", map[string]interface{}{"Field": 1}); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if !reflect.DeepEqual(sut.Partial, ConfigPartial{"node": ConfigPartial{"sub": ConfigPartial{"field": 1}}}) {
+				t.Errorf("stored the (%v) content", sut.Partial)
+			}
+		})
+
+		t.Run("keep the content on error", func(t *testing.T) {
+			sut := NewConfigOverrideSource()
+			_ = sut.Set("node", []interface{}{1})
+
+			if e := sut.Set("node[3]", 2); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrConfigPathNotFound) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrConfigPathNotFound)
+			} else if !reflect.DeepEqual(sut.Partial, ConfigPartial{"node": []interface{}{1}}) {
+				t.Errorf("stored the (%v) content", sut.Partial)
+			}
+		})
+	})
+
+	t.Run("Unset", func(t *testing.T) {
+		t.Run("error if the path is not present", func(t *testing.T) {
+			sut := NewConfigOverrideSource()
+			_ = sut.Set("node", ConfigPartial{"list": []interface{}{1}})
+
+			for _, path := range []string{"other", "node.other", "node.list[1]", "node.list[0].field"} {
+				if e := sut.Unset(path); e == nil {
+					t.Errorf("didn't returned the expected error for (%s)", path)
+				} else if !errors.Is(e, ErrConfigPathNotFound) {
+					t.Errorf("(%v) when expecting (%v)", e, ErrConfigPathNotFound)
+				}
+			}
+		})
+
+		t.Run("remove the path and the emptied partials", func(t *testing.T) {
+			sut := NewConfigOverrideSource()
+			_ = sut.Set("node", ConfigPartial{"sub": ConfigPartial{"field": 1}, "list": []interface{}{1, 2, 3}})
+
+			if e := sut.Unset("node.sub.field"); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if e := sut.Unset("node.list[1]"); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if !reflect.DeepEqual(sut.Partial, ConfigPartial{"node": ConfigPartial{"list": []interface{}{1, 3}}}) {
+				t.Errorf("stored the (%v) content", sut.Partial)
+			}
+		})
+	})
+
+	t.Run("Persist", func(t *testing.T) {
+		t.Run("nil file system", func(t *testing.T) {
+			if e := NewConfigOverrideSource().Persist("overrides.yaml", nil, parserFactory); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrNilPointer) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("nil parser factory", func(t *testing.T) {
+			if e := NewConfigOverrideSource().Persist("overrides.yaml", afero.NewMemMapFs(), nil); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrNilPointer) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("error on invalid persisted content", func(t *testing.T) {
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "overrides.json", []byte("{"), 0o644)
+
+			if e := NewConfigOverrideSource().Persist("overrides.json", fileSystem, parserFactory); e == nil {
+				t.Error("didn't returned the expected error")
+			}
+		})
+
+		t.Run("load the persisted content", func(t *testing.T) {
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "overrides.yaml", []byte("node: value"), 0o644)
+			sut := NewConfigOverrideSource()
+
+			if e := sut.Persist("overrides.yaml", fileSystem, parserFactory); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if !reflect.DeepEqual(sut.Partial, ConfigPartial{"node": "value"}) {
+				t.Errorf("stored the (%v) content", sut.Partial)
+			}
+		})
+
+		t.Run("write the changed content", func(t *testing.T) {
+			fileSystem := afero.NewMemMapFs()
+			sut := NewConfigOverrideSource()
+			_ = sut.Persist("overrides.json", fileSystem, parserFactory)
+
+			if e := sut.Set("node", "value"); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if content, _ := afero.ReadFile(fileSystem, "overrides.json"); string(content) != "{\n  \"node\": \"value\"\n}" {
+				t.Errorf("persisted the (%s) content", content)
+			}
+		})
+	})
+}
+
+func Test_ConfigCacheSource(t *testing.T) {
+	t.Run("NewConfigCacheSource", func(t *testing.T) {
+		t.Run("nil supplier", func(t *testing.T) {
+			sut, e := NewConfigCacheSource(nil, "cache.yaml", afero.NewMemMapFs())
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("nil file system", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sut, e := NewConfigCacheSource(NewMockConfigSupplier(ctrl), "cache.yaml", nil)
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("error retrieving the supplier content", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := fmt.Errorf("error message")
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(nil, expected).Times(1)
+
+			sut, e := NewConfigCacheSource(supplier, "cache.yaml", afero.NewMemMapFs())
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case !errors.Is(e, expected):
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			}
+		})
+
+		t.Run("store the initial cache copy", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fileSystem := afero.NewMemMapFs()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"field": "value"}, nil).Times(1)
+
+			sut, e := NewConfigCacheSource(supplier, "cache/supplier.yaml", fileSystem)
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case sut == nil:
+				t.Error("didn't returned a valid reference")
+			default:
+				if check, _ := afero.ReadFile(fileSystem, "cache/supplier.yaml"); string(check) != "field: value\n" {
+					t.Errorf("stored the (%s) cache copy", check)
+				}
+			}
+		})
+	})
+
+	t.Run("Has and Get", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		supplier := NewMockConfigSupplier(ctrl)
+		supplier.EXPECT().Get("").Return(ConfigPartial{"field": "value"}, nil).Times(1)
+		supplier.EXPECT().Has("field").Return(true).Times(1)
+		supplier.EXPECT().Get("field").Return("value", nil).Times(1)
+		sut, _ := NewConfigCacheSource(supplier, "cache.yaml", afero.NewMemMapFs())
+
+		if !sut.Has("field") {
+			t.Error("didn't delegated the presence check")
+		} else if check, _ := sut.Get("field"); check != "value" {
+			t.Errorf("(%v) when expecting (value)", check)
+		}
+	})
+
+	t.Run("Reload", func(t *testing.T) {
+		t.Run("non observable supplier", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
+			sut, _ := NewConfigCacheSource(supplier, "cache.yaml", afero.NewMemMapFs())
+
+			if updated, e := sut.Reload(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if updated {
+				t.Error("reported an update")
+			}
+		})
+
+		t.Run("keep the cache copy on reload failure", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := fmt.Errorf("error message")
+			fileSystem := afero.NewMemMapFs()
+			supplier := NewMockConfigObsSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"field": "value"}, nil).Times(1)
+			supplier.EXPECT().Reload().Return(false, expected).Times(1)
+			sut, _ := NewConfigCacheSource(supplier, "cache.yaml", fileSystem)
+
+			if _, e := sut.Reload(); !errors.Is(e, expected) {
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			} else if check, _ := afero.ReadFile(fileSystem, "cache.yaml"); string(check) != "field: value\n" {
+				t.Errorf("stored the (%s) cache copy", check)
+			}
+		})
+
+		t.Run("update the cache copy on reload", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fileSystem := afero.NewMemMapFs()
+			supplier := NewMockConfigObsSupplier(ctrl)
+			gomock.InOrder(
+				supplier.EXPECT().Get("").Return(ConfigPartial{"field": "value"}, nil),
+				supplier.EXPECT().Reload().Return(true, nil),
+				supplier.EXPECT().Get("").Return(ConfigPartial{"field": "other"}, nil),
+			)
+			sut, _ := NewConfigCacheSource(supplier, "cache.yaml", fileSystem)
+
+			if updated, e := sut.Reload(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if !updated {
+				t.Error("didn't reported the update")
+			} else if check, _ := afero.ReadFile(fileSystem, "cache.yaml"); string(check) != "field: other\n" {
+				t.Errorf("stored the (%s) cache copy", check)
+			}
+		})
+	})
+
+	t.Run("Refresh", func(t *testing.T) {
+		t.Run("update the cache copy on refresh", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fileSystem := afero.NewMemMapFs()
+			supplier := NewMockConfigRefreshSupplier(ctrl)
+			gomock.InOrder(
+				supplier.EXPECT().Get("").Return(ConfigPartial{"field": "value"}, nil),
+				supplier.EXPECT().Refresh().Return(nil),
+				supplier.EXPECT().Get("").Return(ConfigPartial{"field": "other"}, nil),
+			)
+			sut, _ := NewConfigCacheSource(supplier, "cache.yaml", fileSystem)
+
+			if e := sut.Refresh(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if check, _ := afero.ReadFile(fileSystem, "cache.yaml"); string(check) != "field: other\n" {
+				t.Errorf("stored the (%s) cache copy", check)
+			}
+		})
+
+		t.Run("error refreshing the supplier", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := fmt.Errorf("error message")
+			supplier := NewMockConfigRefreshSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
+			supplier.EXPECT().Refresh().Return(expected).Times(1)
+			sut, _ := NewConfigCacheSource(supplier, "cache.yaml", afero.NewMemMapFs())
+
+			if e := sut.Refresh(); !errors.Is(e, expected) {
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			}
+		})
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		supplier := NewMockConfigSupplier(ctrl)
+		supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
+		supplier.EXPECT().Close().Return(nil).Times(1)
+		sut, _ := NewConfigCacheSource(supplier, "cache.yaml", afero.NewMemMapFs())
+
+		if e := sut.Close(); e != nil {
+			t.Errorf("unexpected (%v) error", e)
+		}
+	})
+}
+
+func Test_ConfigCaseSource(t *testing.T) {
+	t.Run("NewConfigCaseSource", func(t *testing.T) {
+		t.Run("nil supplier", func(t *testing.T) {
+			sut, e := NewConfigCaseSource(nil, ConfigCaseLower)
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("invalid case policy", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sut, e := NewConfigCaseSource(NewMockConfigSupplier(ctrl), "invalid")
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case !errors.Is(e, ErrInvalidConfigCase):
+				t.Errorf("(%v) when expecting (%v)", e, ErrInvalidConfigCase)
+			}
+		})
+
+		t.Run("error retrieving the supplier content", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := fmt.Errorf("error message")
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(nil, expected).Times(1)
+
+			sut, e := NewConfigCaseSource(supplier, ConfigCaseLower)
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case !errors.Is(e, expected):
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			}
+		})
+
+		t.Run("normalize the supplier content", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"Node": []interface{}{ConfigPartial{"Field": "Value"}}}, nil).Times(1)
+
+			sut, e := NewConfigCaseSource(supplier, ConfigCaseLower)
+			expected := ConfigPartial{"node": []interface{}{ConfigPartial{"field": "Value"}}}
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(sut.Partial, expected):
+				t.Errorf("(%v) when expecting (%v)", sut.Partial, expected)
+			}
+		})
+
+		t.Run("preserve the parsed supplier keys case", func(t *testing.T) {
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "config.yaml", []byte("Headers: {Content-Type: json}"), 0o644)
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigYAMLDecoderCreator()})
+			supplier, _ := NewConfigFileSource("config.yaml", ConfigFormatYAML, fileSystem, parserFactory)
+
+			sut, e := NewConfigCaseSource(supplier, ConfigCasePreserve)
+			expected := ConfigPartial{"Headers": ConfigPartial{"Content-Type": "json"}}
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(sut.Partial, expected):
+				t.Errorf("(%v) when expecting (%v)", sut.Partial, expected)
+			case sut.Has("headers.content-type"):
+				t.Error("found a path differing in case")
+			}
+		})
+
+		t.Run("lookup the preserved keys case-insensitively", func(t *testing.T) {
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "config.json", []byte(`{"Headers": [{"Content-Type": "json"}]}`), 0o644)
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()})
+			supplier, _ := NewConfigFileSource("config.json", ConfigFormatJSON, fileSystem, parserFactory)
+
+			sut, e := NewConfigCaseSource(supplier, ConfigCaseInsensitive)
+			expected := ConfigPartial{"Headers": []interface{}{ConfigPartial{"Content-Type": "json"}}}
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(sut.Partial, expected):
+				t.Errorf("(%v) when expecting (%v)", sut.Partial, expected)
+			case !sut.Has("headers[0].content-type"):
+				t.Error("didn't found a path differing in case")
+			default:
+				if check, _ := sut.Get("HEADERS[0].content-TYPE"); check != "json" {
+					t.Errorf("(%v) when expecting (json)", check)
+				}
+			}
+		})
+
+		t.Run("keep the content of a non parsing supplier", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"Field": "Value"}, nil).Times(1)
+
+			sut, e := NewConfigCaseSource(supplier, ConfigCasePreserve)
+			expected := ConfigPartial{"Field": "Value"}
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(sut.Partial, expected):
+				t.Errorf("(%v) when expecting (%v)", sut.Partial, expected)
+			}
+		})
+	})
+
+	t.Run("Reload", func(t *testing.T) {
+		t.Run("non observable supplier", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
+			sut, _ := NewConfigCaseSource(supplier, ConfigCaseLower)
+
+			if updated, e := sut.Reload(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if updated {
+				t.Error("reported an update")
+			}
+		})
+
+		t.Run("normalize the reloaded content", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			supplier := NewMockConfigObsSupplier(ctrl)
+			gomock.InOrder(
+				supplier.EXPECT().Get("").Return(ConfigPartial{"Field": "value"}, nil),
+				supplier.EXPECT().Reload().Return(true, nil),
+				supplier.EXPECT().Get("").Return(ConfigPartial{"Field": "other"}, nil),
+			)
+			sut, _ := NewConfigCaseSource(supplier, ConfigCaseLower)
+
+			if updated, e := sut.Reload(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if !updated {
+				t.Error("didn't reported the update")
+			} else if check, _ := sut.Get("field"); check != "other" {
+				t.Errorf("(%v) when expecting (other)", check)
+			}
+		})
+	})
+
+	t.Run("Refresh", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		supplier := NewMockConfigRefreshSupplier(ctrl)
+		gomock.InOrder(
+			supplier.EXPECT().Get("").Return(ConfigPartial{"Field": "value"}, nil),
+			supplier.EXPECT().Refresh().Return(nil),
+			supplier.EXPECT().Get("").Return(ConfigPartial{"Field": "other"}, nil),
+		)
+		sut, _ := NewConfigCaseSource(supplier, ConfigCaseLower)
+
+		if e := sut.Refresh(); e != nil {
+			t.Errorf("unexpected (%v) error", e)
+		} else if check, _ := sut.Get("field"); check != "other" {
+			t.Errorf("(%v) when expecting (other)", check)
+		}
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		supplier := NewMockConfigSupplier(ctrl)
+		supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
+		supplier.EXPECT().Close().Return(nil).Times(1)
+		sut, _ := NewConfigCaseSource(supplier, ConfigCaseLower)
+
+		if e := sut.Close(); e != nil {
+			t.Errorf("unexpected (%v) error", e)
+		}
+	})
+}
+
+func Test_ObserveAs(t *testing.T) {
+	type server struct {
+		Host string
+		Port int
+	}
+
+	t.Run("nil config", func(t *testing.T) {
+		if _, e := ObserveAs(nil, "path", func(_, _ server) {}); !errors.Is(e, ErrNilPointer) {
+			t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+		}
+	})
+
+	t.Run("nil callback", func(t *testing.T) {
+		if _, e := ObserveAs[server](NewConfig(), "path", nil); !errors.Is(e, ErrNilPointer) {
+			t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+		}
+	})
+
+	t.Run("error if path not present", func(t *testing.T) {
+		if _, e := ObserveAs(NewConfig(), "path", func(_, _ server) {}); !errors.Is(e, ErrConfigPathNotFound) {
+			t.Errorf("(%v) when expecting (%v)", e, ErrConfigPathNotFound)
+		}
+	})
+
+	t.Run("notify the populated values", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ConfigObserveFrequency = 0
+		sut := NewConfig()
+		supplier1 := NewMockConfigSupplier(ctrl)
+		supplier1.EXPECT().Get("").Return(ConfigPartial{"server": ConfigPartial{"host": "localhost", "port": 80}}, nil).AnyTimes()
+		_ = sut.AddSupplier("supplier1", 0, supplier1)
+
+		var calls [][2]server
+		if _, e := ObserveAs(sut, "server", func(old, new server) {
+			calls = append(calls, [2]server{old, new})
+		}); e != nil {
+			t.Errorf("unexpected (%v) error", e)
+		}
+
+		supplier2 := NewMockConfigSupplier(ctrl)
+		supplier2.EXPECT().Get("").Return(ConfigPartial{"server": ConfigPartial{"port": 8080}}, nil).AnyTimes()
+		_ = sut.AddSupplier("supplier2", 1, supplier2)
+
+		expected := [][2]server{{{Host: "localhost", Port: 80}, {Host: "localhost", Port: 8080}}}
+		if !reflect.DeepEqual(calls, expected) {
+			t.Errorf("(%v) when expecting (%v)", calls, expected)
+		}
+	})
+
+	t.Run("call immediately with the current value", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ConfigObserveFrequency = 0
+		sut := NewConfig()
+		supplier := NewMockConfigSupplier(ctrl)
+		supplier.EXPECT().Get("").Return(ConfigPartial{"port": 80}, nil).AnyTimes()
+		_ = sut.AddSupplier("supplier", 0, supplier)
+
+		var calls [][2]int
+		_, _ = ObserveAs(sut, "port", func(old, new int) {
+			calls = append(calls, [2]int{old, new})
+		}, ConfigObserveOptions{Immediate: true})
+
+		if expected := [][2]int{{0, 80}}; !reflect.DeepEqual(calls, expected) {
+			t.Errorf("(%v) when expecting (%v)", calls, expected)
+		}
+	})
+
+	t.Run("report conversion errors to the error handler", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ConfigObserveFrequency = 0
+		sut := NewConfig()
+		supplier1 := NewMockConfigSupplier(ctrl)
+		supplier1.EXPECT().Get("").Return(ConfigPartial{"port": 80}, nil).AnyTimes()
+		_ = sut.AddSupplier("supplier1", 0, supplier1)
+
+		called := false
+		var failures []error
+		_, _ = ObserveAs(sut, "port", func(_, _ int) {
+			called = true
+		}, ConfigObserveOptions{ErrorHandler: func(e error) { failures = append(failures, e) }})
+
+		supplier2 := NewMockConfigSupplier(ctrl)
+		supplier2.EXPECT().Get("").Return(ConfigPartial{"port": "invalid"}, nil).AnyTimes()
+		_ = sut.AddSupplier("supplier2", 1, supplier2)
+
+		switch {
+		case called:
+			t.Error("called the observer with an invalid value")
+		case len(failures) != 1:
+			t.Errorf("reported (%d) failures", len(failures))
+		case !errors.Is(failures[0], ErrConversion):
+			t.Errorf("(%v) when expecting (%v)", failures[0], ErrConversion)
+		}
+	})
+
+	t.Run("report conversion errors to the config error handlers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ConfigObserveFrequency = 0
+		sut := NewConfig()
+		supplier := NewMockConfigSupplier(ctrl)
+		supplier.EXPECT().Get("").Return(ConfigPartial{"port": "invalid"}, nil).AnyTimes()
+		_ = sut.AddSupplier("supplier", 0, supplier)
+		var failures []error
+		_ = sut.AddErrorHandler(func(e error) { failures = append(failures, e) })
+
+		_, _ = ObserveAs(sut, "port", func(_, _ int) {}, ConfigObserveOptions{Immediate: true})
+
+		if len(failures) != 1 {
+			t.Errorf("reported (%d) failures", len(failures))
+		} else if !errors.Is(failures[0], ErrConversion) {
+			t.Errorf("(%v) when expecting (%v)", failures[0], ErrConversion)
+		}
+	})
+
+	t.Run("record conversion errors as warnings if no error handler", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ConfigObserveFrequency = 0
+		sut := NewConfig()
+		supplier := NewMockConfigSupplier(ctrl)
+		supplier.EXPECT().Get("").Return(ConfigPartial{"port": "invalid"}, nil).AnyTimes()
+		_ = sut.AddSupplier("supplier", 0, supplier)
+		var warned []error
+		_ = sut.AddWarningHandler(func(e error) {
+			_ = sut.Warnings()
+			warned = append(warned, e)
+		})
+
+		_, _ = ObserveAs(sut, "port", func(_, _ int) {}, ConfigObserveOptions{Immediate: true})
+
+		warnings := sut.Warnings()
+		switch {
+		case len(warnings) != 1:
+			t.Errorf("recorded (%d) warnings", len(warnings))
+		case !errors.Is(warnings[0], ErrConversion):
+			t.Errorf("(%v) when expecting (%v)", warnings[0], ErrConversion)
+		case len(warned) != 1:
+			t.Errorf("reported (%d) warnings", len(warned))
+		case !errors.Is(warned[0], ErrConversion):
+			t.Errorf("(%v) when expecting (%v)", warned[0], ErrConversion)
+		}
+	})
+
+	t.Run("report conversion errors after releasing the config lock", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ConfigObserveFrequency = 0
+		sut := NewConfig()
+		supplier1 := NewMockConfigSupplier(ctrl)
+		supplier1.EXPECT().Get("").Return(ConfigPartial{"port": 80}, nil).AnyTimes()
+		_ = sut.AddSupplier("supplier1", 0, supplier1)
+		var reported []interface{}
+		_ = sut.AddErrorHandler(func(_ error) {
+			value, _ := sut.Get("port")
+			reported = append(reported, value)
+		})
+		_, _ = ObserveAs(sut, "port", func(_, _ int) {})
+
+		supplier2 := NewMockConfigSupplier(ctrl)
+		supplier2.EXPECT().Get("").Return(ConfigPartial{"port": "invalid"}, nil).AnyTimes()
+		done := make(chan error, 1)
+		go func() { done <- sut.AddSupplier("supplier2", 1, supplier2) }()
+
+		select {
+		case e := <-done:
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(reported, []interface{}{"invalid"}):
+				t.Errorf("(%v) when expecting ([invalid])", reported)
+			}
+		case <-time.After(time.Second):
+			t.Error("the error handler deadlocked on the config access")
+		}
+	})
+}
+
+func Test_Config(t *testing.T) {
+	t.Run("NewConfig", func(t *testing.T) {
+		t.Run("new config without reload", func(t *testing.T) {
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+
+			switch {
+			case sut.mutex == nil:
+				t.Error("didn't instantiate the access mutex")
+			case sut.suppliers == nil:
+				t.Error("didn't instantiate the suppliers storing array")
+			case sut.observers == nil:
+				t.Error("didn't instantiate the observers storing array")
+			case sut.observer != nil:
+				t.Error("instantiated the suppliers reload trigger")
+			}
+		})
+
+		t.Run("new config with reload", func(t *testing.T) {
+			ConfigObserveFrequency = 10
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+
+			switch {
+			case sut.mutex == nil:
+				t.Error("didn't instantiate the access mutex")
+			case sut.suppliers == nil:
+				t.Error("didn't instantiate the suppliers storing array")
+			case sut.observers == nil:
+				t.Error("didn't instantiate the observers storing array")
+			case sut.observer == nil:
+				t.Error("didn't instantiate the suppliers reload trigger")
+			}
+		})
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		t.Run("error while closing supplier", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := fmt.Errorf("error message")
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).AnyTimes()
+			supplier.EXPECT().Close().Return(expected).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if e := sut.Close(); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if e.Error() != expected.Error() {
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			}
+		})
+		t.Run("error while closing observer", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := fmt.Errorf("error message")
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).AnyTimes()
+			supplier.EXPECT().Close().Return(nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+			observer := NewMockTrigger(ctrl)
+			observer.EXPECT().Close().Return(expected).Times(1)
+			sut.observer = observer
+
+			if e := sut.Close(); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if e.Error() != expected.Error() {
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			}
+		})
+
+		t.Run("propagate close to suppliers", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			id1 := "supplier.1"
+			id2 := "supplier.2"
+			priority1 := 0
+			priority2 := 1
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{}, nil).AnyTimes()
+			supplier1.EXPECT().Close().Times(1)
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{}, nil).AnyTimes()
+			supplier2.EXPECT().Close().Times(1)
+			_ = sut.AddSupplier(id1, priority1, supplier1)
+			_ = sut.AddSupplier(id2, priority2, supplier2)
+
+			_ = sut.Close()
+		})
+	})
+
+	t.Run("Entries", func(t *testing.T) {
+		t.Run("return partial entries", func(t *testing.T) {
+			scenarios := []struct {
+				config   ConfigPartial
+				expected []string
+			}{
+				{ // _test the empty partial
+					config:   ConfigPartial{},
+					expected: nil,
+				},
+				{ // _test the single entry partial
+					config:   ConfigPartial{"field": "value"},
+					expected: []string{"field"},
+				},
+				{ // _test the multi entry partial
+					config:   ConfigPartial{"field1": "value 1", "field2": "value 2"},
+					expected: []string{"field1", "field2"},
+				},
+			}
+
+			for _, s := range scenarios {
+				test := func() {
+					ctrl := gomock.NewController(t)
+
+					ConfigObserveFrequency = 0
+					sut := NewConfig()
+					supplier := NewMockConfigSupplier(ctrl)
+					supplier.EXPECT().Close().Times(1)
+					supplier.EXPECT().Get("").Return(s.config, nil).Times(1)
+					_ = sut.AddSupplier("supplier", 0, supplier)
+
+					defer func() { _ = sut.Close(); ctrl.Finish() }()
+
+					check := sut.Entries()
+
+					sort.Strings(s.expected)
+					sort.Strings(check)
+					if !reflect.DeepEqual(check, s.expected) {
+						t.Errorf("(%v) when expecting (%v)", check, s.expected)
+					}
+				}
+				test()
+			}
+		})
+	})
+
+	t.Run("Has", func(t *testing.T) {
+		t.Run("return the existence of the path", func(t *testing.T) {
+			scenarios := []struct {
+				config   ConfigPartial
+				search   string
+				expected bool
+			}{
 				{ // _test the existence of a present path
 					config:   ConfigPartial{"node": "value"},
 					search:   "node",
 					expected: true,
 				},
-				{ // _test the non-existence of a missing path
-					config:   ConfigPartial{"node": "value"},
-					search:   "invalid-node",
-					expected: false,
+				{ // _test the non-existence of a missing path
+					config:   ConfigPartial{"node": "value"},
+					search:   "invalid-node",
+					expected: false,
+				},
+			}
+
+			for _, s := range scenarios {
+				test := func() {
+					ctrl := gomock.NewController(t)
+
+					ConfigObserveFrequency = 0
+					sut := NewConfig()
+					supplier := NewMockConfigSupplier(ctrl)
+					supplier.EXPECT().Close().Times(1)
+					supplier.EXPECT().Get("").Return(s.config, nil).Times(1)
+					_ = sut.AddSupplier("supplier", 0, supplier)
+
+					defer func() { _ = sut.Close(); ctrl.Finish() }()
+
+					if check := sut.Has(s.search); check != s.expected {
+						t.Errorf("(%v) when expecting (%v)", check, s.expected)
+					}
+				}
+				test()
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Run("return path value", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			search := "node"
+			expected := "value"
+			config := ConfigPartial{search: expected}
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(config, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			defer func() { _ = sut.Close() }()
+
+			if check, e := sut.Get(search); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if check != expected {
+				t.Errorf("(%v) when expecting (%v)", check, expected)
+			}
+		})
+
+		t.Run("return internal partial get error", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			data := ConfigPartial{"node1": ConfigPartial{"node2": 101}}
+			path := "node3"
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(data, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			check, e := sut.Get(path)
+			switch {
+			case check != nil:
+				t.Errorf("unexpected valid value : %v", check)
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrConfigPathNotFound):
+				t.Errorf("(%v) when expecting (%v)", e, ErrConfigPathNotFound)
+			}
+		})
+
+		t.Run("return simple if path was not found", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			data := ConfigPartial{"node1": ConfigPartial{"node2": 101}}
+			path := "node3"
+			val := 3
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(data, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if check, e := sut.Get(path, val); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if check != val {
+				t.Errorf("(%v) when expecting (%v)", check, val)
+			}
+		})
+	})
+
+	t.Run("Bool", func(t *testing.T) {
+		t.Run("return the stored boolean value", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			path := "node"
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{path: true}, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if check, e := sut.Bool(path); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if !check {
+				t.Errorf("returned (%v)", check)
+			}
+		})
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		t.Run("return the stored integer value", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			value := 123
+			path := "node"
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{path: value}, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if check, e := sut.Int(path); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if check != value {
+				t.Errorf("returned (%v) when expecting : %v", check, value)
+			}
+		})
+	})
+
+	t.Run("Float", func(t *testing.T) {
+		t.Run("return the stored integer value", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			value := 123.4
+			path := "node"
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{path: value}, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if check, e := sut.Float(path); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if check != value {
+				t.Errorf("returned (%v) when expecting : %v", check, value)
+			}
+		})
+	})
+
+	t.Run("String", func(t *testing.T) {
+		t.Run("return the stored integer value", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			value := "value"
+			path := "node"
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{path: value}, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if check, e := sut.String(path); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if check != value {
+				t.Errorf("returned (%v) when expecting : %v", check, value)
+			}
+		})
+	})
+
+	t.Run("List", func(t *testing.T) {
+		t.Run("return the stored integer value", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			value := []interface{}{1, 2, 3}
+			path := "node"
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{path: value}, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if check, e := sut.List(path); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if !reflect.DeepEqual(check, value) {
+				t.Errorf("returned (%v) when expecting : %v", check, value)
+			}
+		})
+	})
+
+	t.Run("Partial", func(t *testing.T) {
+		t.Run("return the stored partial value", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			value := ConfigPartial{"field": "value"}
+			path := "node"
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{path: value}, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if check, e := sut.Partial(path); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if !reflect.DeepEqual(check, value) {
+				t.Errorf("returned (%v) when expecting : %v", check, value)
+			}
+		})
+	})
+
+	t.Run("Duration", func(t *testing.T) {
+		t.Run("return the stored duration value", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			path := "node"
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{path: "1m30s"}, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if check, e := sut.Duration(path); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if check != 90*time.Second {
+				t.Errorf("returned (%v) when expecting : %v", check, 90*time.Second)
+			}
+		})
+	})
+
+	t.Run("ByteSize", func(t *testing.T) {
+		t.Run("return the stored byte size value", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			path := "node"
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{path: "10MB"}, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if check, e := sut.ByteSize(path); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if check != 10*1000*1000 {
+				t.Errorf("returned (%v) when expecting : %v", check, 10*1000*1000)
+			}
+		})
+	})
+
+	t.Run("StringList", func(t *testing.T) {
+		t.Run("return the stored string list value", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			path := "node"
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{path: "a,b"}, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if check, e := sut.StringList(path); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if !reflect.DeepEqual(check, []string{"a", "b"}) {
+				t.Errorf("returned (%v) when expecting : [a b]", check)
+			}
+		})
+	})
+
+	t.Run("Populate", func(t *testing.T) {
+		t.Run("populate the given structure", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			value := ConfigPartial{"field": ConfigPartial{"field": "value"}}
+			target := struct{ Field string }{}
+			expected := struct{ Field string }{Field: "value"}
+			path := "node"
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{path: value}, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if check, e := sut.Populate(path+"."+"field", target); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if !reflect.DeepEqual(check, expected) {
+				t.Errorf("returned (%v) when expecting : %v", check, expected)
+			}
+		})
+	})
+
+	t.Run("HasSupplier", func(t *testing.T) {
+		t.Run("validate if the supplier is registered", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if !sut.HasSupplier("supplier") {
+				t.Error("returned false")
+			}
+		})
+
+		t.Run("invalidate if the supplier is not registered", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if sut.HasSupplier("invalid supplier id") {
+				t.Error("returned true")
+			}
+		})
+	})
+
+	t.Run("AddSupplier", func(t *testing.T) {
+		t.Run("nil supplier", func(t *testing.T) {
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+
+			if e := sut.AddSupplier("supplier", 0, nil); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrNilPointer) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("register a new supplier", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
+
+			if e := sut.AddSupplier("supplier", 0, supplier); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if !sut.HasSupplier("supplier") {
+				t.Error("didn't stored the supplier")
+			}
+		})
+
+		t.Run("duplicate id", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if e := sut.AddSupplier("supplier", 0, supplier); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrDuplicateConfigSupplier) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrDuplicateConfigSupplier)
+			}
+		})
+
+		t.Run("override path if the insert have higher priority", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Close().Times(1)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value.1"}, nil).AnyTimes()
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Close().Times(1)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "value.2"}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier.1", 1, supplier1)
+			_ = sut.AddSupplier("supplier.2", 2, supplier2)
+
+			if check, _ := sut.Get("node"); check != "value.2" {
+				t.Errorf("returned the (%v) value when expecting (value.2)", check)
+			}
+		})
+
+		t.Run("do not override path if the insert have lower priority", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Close().Times(1)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value.1"}, nil).AnyTimes()
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Close().Times(1)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "value.2"}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier.1", 2, supplier1)
+			_ = sut.AddSupplier("supplier.2", 1, supplier2)
+
+			if check, _ := sut.Get("node"); check != "value.1" {
+				t.Errorf("returned the (%v) value when expecting (value.1)", check)
+			}
+		})
+
+		t.Run("still be able to get not overridden paths of a inserted lower priority", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Close().Times(1)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value.1"}, nil).AnyTimes()
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Close().Times(1)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "value.2", "extendedNode": "extendedValue"}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier.1", 2, supplier1)
+			_ = sut.AddSupplier("supplier.2", 1, supplier2)
+
+			if check, _ := sut.Get("extendedNode"); check != "extendedValue" {
+				t.Errorf("returned the (%v) value when expecting (extendedValue)", check)
+			}
+		})
+	})
+
+	t.Run("RemoveSupplier", func(t *testing.T) {
+		t.Run("unregister a non-registered supplier", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+
+			if e := sut.RemoveSupplier("supplier"); e != nil {
+				t.Errorf("unexpected error (%v)", e)
+			}
+		})
+
+		t.Run("error unregister a previously registered supplier", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := fmt.Errorf("error message")
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Return(expected).Times(2)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value.1"}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if e := sut.RemoveSupplier("supplier"); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if e.Error() != expected.Error() {
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			}
+		})
+
+		t.Run("unregister a previously registered supplier", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Close().Times(1)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value.1"}, nil).AnyTimes()
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Close().Times(1)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "value.2"}, nil).AnyTimes()
+			supplier3 := NewMockConfigSupplier(ctrl)
+			supplier3.EXPECT().Close().Times(1)
+			supplier3.EXPECT().Get("").Return(ConfigPartial{"node": "value.3"}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier.1", 0, supplier1)
+			_ = sut.AddSupplier("supplier.2", 0, supplier2)
+			_ = sut.AddSupplier("supplier.3", 0, supplier3)
+			_ = sut.RemoveSupplier("supplier.2")
+
+			if sut.HasSupplier("supplier.2") {
+				t.Error("didn't remove the supplier")
+			}
+		})
+
+		t.Run("recover path overridden by the removed supplier", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Close().Times(1)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value.1"}, nil).AnyTimes()
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Close().Times(1)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "value.2"}, nil).AnyTimes()
+			supplier3 := NewMockConfigSupplier(ctrl)
+			supplier3.EXPECT().Close().Times(1)
+			supplier3.EXPECT().Get("").Return(ConfigPartial{"node": "value.3"}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier.1", 0, supplier1)
+			_ = sut.AddSupplier("supplier.2", 1, supplier2)
+			_ = sut.AddSupplier("supplier.3", 2, supplier3)
+			_ = sut.RemoveSupplier("supplier.3")
+
+			if check, _ := sut.Get("node"); check != "value.2" {
+				t.Errorf("returned (%check) value when expecting (value.2)", check)
+			}
+		})
+	})
+
+	t.Run("RemoveAllSuppliers", func(t *testing.T) {
+		t.Run("remove all the suppliers", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+
+			expected := fmt.Errorf("error string")
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Close().MinTimes(1)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value.1"}, nil).AnyTimes()
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Close().MinTimes(1)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "value.2"}, nil).AnyTimes()
+			supplier3 := NewMockConfigSupplier(ctrl)
+			supplier3.EXPECT().Close().Return(expected).MinTimes(1)
+			supplier3.EXPECT().Get("").Return(ConfigPartial{"node": "value.3"}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier.1", 0, supplier1)
+			_ = sut.AddSupplier("supplier.2", 1, supplier2)
+			_ = sut.AddSupplier("supplier.3", 2, supplier3)
+
+			if e := sut.RemoveAllSuppliers(); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if e.Error() != expected.Error() {
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			}
+		})
+
+		t.Run("remove all the suppliers", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Close().Times(1)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value.1"}, nil).AnyTimes()
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Close().Times(1)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "value.2"}, nil).AnyTimes()
+			supplier3 := NewMockConfigSupplier(ctrl)
+			supplier3.EXPECT().Close().Times(1)
+			supplier3.EXPECT().Get("").Return(ConfigPartial{"node": "value.3"}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier.1", 0, supplier1)
+			_ = sut.AddSupplier("supplier.2", 1, supplier2)
+			_ = sut.AddSupplier("supplier.3", 2, supplier3)
+			_ = sut.RemoveAllSuppliers()
+
+			if len(sut.suppliers) != 0 {
+				t.Error("didn't removed all the registered suppliers")
+			}
+		})
+	})
+
+	t.Run("Supplier", func(t *testing.T) {
+		t.Run("error if the supplier don't exists", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+
+			check, e := sut.Supplier("invalid id")
+			switch {
+			case check != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrConfigSupplierNotFound):
+				t.Errorf("(%v) when expecting (%v)", e, ErrConfigSupplierNotFound)
+			}
+		})
+
+		t.Run("return the registered supplier", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			check, e := sut.Supplier("supplier")
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case check == nil:
+				t.Error("returned nil")
+			case !reflect.DeepEqual(check, supplier):
+				t.Errorf("(%v) when expecting (%v)", check, supplier)
+			}
+		})
+	})
+
+	t.Run("SupplierPriority", func(t *testing.T) {
+		t.Run("error if the supplier was not found", func(t *testing.T) {
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+
+			if e := sut.SupplierPriority("invalid id", 0); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrConfigSupplierNotFound) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrConfigSupplierNotFound)
+			}
+		})
+
+		t.Run("update the priority of the supplier", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Close().Times(1)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value.1"}, nil).AnyTimes()
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Close().Times(1)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "value.2"}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier.1", 1, supplier1)
+			_ = sut.AddSupplier("supplier.2", 2, supplier2)
+
+			if check, _ := sut.Get("node"); check != "value.2" {
+				t.Errorf("returned the (%v) value prior the change, when expecting (value.2)", check)
+			}
+			if e := sut.SupplierPriority("supplier.2", 0); e != nil {
+				t.Errorf("returned the unexpeced error : (%v)", e)
+			}
+			if check, _ := sut.Get("node"); check != "value.1" {
+				t.Errorf("returned the (%v) value after the change, when expecting (value.1)", check)
+			}
+		})
+	})
+
+	t.Run("SupplierInterval", func(t *testing.T) {
+		t.Run("error if the supplier is not found", func(t *testing.T) {
+			if e := NewConfig().SupplierInterval("supplier", time.Second); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrConfigSupplierNotFound) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrConfigSupplierNotFound)
+			}
+		})
+
+		t.Run("remove the supplier from the config polling", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if e := sut.SupplierInterval("supplier", time.Second); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if refs := sut.supplierRefs(false); len(refs) != 0 {
+				t.Errorf("polling (%d) suppliers", len(refs))
+			} else if sut.suppliers[0].trigger != nil {
+				t.Error("created a trigger for a non-observable supplier")
+			}
+		})
+
+		t.Run("poll the observable supplier with its own interval", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+
+			supplier := NewMockConfigObsSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value"}, nil).Times(1)
+			supplier.EXPECT().Reload().Return(false, nil).MinTimes(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+			_ = sut.SupplierInterval("supplier", 10*time.Millisecond)
+
+			time.Sleep(100 * time.Millisecond)
+			_ = sut.Close()
+		})
+	})
+
+	t.Run("Reload", func(t *testing.T) {
+		t.Run("nil context", func(t *testing.T) {
+			//nolint:staticcheck
+			if _, e := NewConfig().Reload(nil); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrNilPointer) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("return the reload changes", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			supplier := NewMockConfigObsSupplier(ctrl)
+			gomock.InOrder(
+				supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value1"}, nil),
+				supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value2"}, nil),
+			)
+			supplier.EXPECT().Reload().Return(true, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			changes, e := sut.Reload(context.Background())
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(changes, []ConfigChange{{Path: "node", Old: "value1", New: "value2"}}):
+				t.Errorf("returned the (%v) changes", changes)
+			}
+		})
+
+		t.Run("rebuild with the partial update of a failing supplier", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := fmt.Errorf("error message")
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			supplier := NewMockConfigObsSupplier(ctrl)
+			gomock.InOrder(
+				supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value1"}, nil),
+				supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value2"}, nil),
+			)
+			supplier.EXPECT().Reload().Return(true, expected).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if _, e := sut.Reload(context.Background()); !errors.Is(e, expected) {
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			} else if check, _ := sut.String("node"); check != "value2" {
+				t.Errorf("retrieved the (%v) value", check)
+			}
+		})
+
+		t.Run("refresh the suppliers if requested", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			supplier := NewMockConfigRefreshSupplier(ctrl)
+			gomock.InOrder(
+				supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value1"}, nil),
+				supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value2"}, nil),
+			)
+			supplier.EXPECT().Refresh().Return(nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if changes, _ := sut.Reload(context.Background()); len(changes) != 0 {
+				t.Errorf("returned the (%v) changes without refreshing", changes)
+			} else if changes, e := sut.Reload(context.Background(), ConfigReloadOptions{Refresh: true}); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if len(changes) != 1 {
+				t.Errorf("returned the (%v) changes", changes)
+			}
+		})
+
+		t.Run("don't rebuild if the context is canceled", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			supplier := NewMockConfigObsSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value"}, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			if _, e := sut.Reload(ctx); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, context.Canceled) {
+				t.Errorf("(%v) when expecting (%v)", e, context.Canceled)
+			}
+		})
+
+		t.Run("rebuild the already reloaded suppliers if the context is canceled", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			ctx, cancel := context.WithCancel(context.Background())
+			supplier1 := NewMockConfigObsSupplier(ctrl)
+			gomock.InOrder(
+				supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value1"}, nil).Times(2),
+				supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value2"}, nil),
+			)
+			supplier1.EXPECT().Reload().DoAndReturn(func() (bool, error) {
+				cancel()
+				return true, nil
+			}).Times(1)
+			supplier2 := NewMockConfigObsSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(2)
+			_ = sut.AddSupplier("supplier1", 0, supplier1)
+			_ = sut.AddSupplier("supplier2", 1, supplier2)
+
+			changes, e := sut.Reload(ctx)
+			switch {
+			case !errors.Is(e, context.Canceled):
+				t.Errorf("(%v) when expecting (%v)", e, context.Canceled)
+			case !reflect.DeepEqual(changes, []ConfigChange{{Path: "node", Old: "value1", New: "value2"}}):
+				t.Errorf("returned the (%v) changes", changes)
+			}
+		})
+	})
+
+	t.Run("ReloadOnSignal", func(t *testing.T) {
+		t.Run("reload on the hang-up signal", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			supplier := NewMockConfigRefreshSupplier(ctrl)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value"}, nil).MinTimes(2)
+			supplier.EXPECT().Refresh().Return(nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+			sut.ReloadOnSignal()
+
+			process, _ := os.FindProcess(os.Getpid())
+			_ = process.Signal(syscall.SIGHUP)
+			time.Sleep(100 * time.Millisecond)
+			_ = sut.Close()
+		})
+	})
+
+	t.Run("Explain", func(t *testing.T) {
+		t.Run("error if path not present", func(t *testing.T) {
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+
+			if _, e := sut.Explain("path"); e == nil {
+				t.Errorf("didn't returned the expected error")
+			} else if !errors.Is(e, ErrConfigPathNotFound) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrConfigPathNotFound)
+			}
+		})
+
+		t.Run("explain the path value provenance", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": ConfigPartial{"field": 1}}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier1", 0, supplier1)
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"other": 2}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier2", 10, supplier2)
+			supplier3 := NewMockConfigSupplier(ctrl)
+			supplier3.EXPECT().Get("").Return(ConfigPartial{"node": ConfigPartial{"field": 3}}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier3", 20, supplier3)
+
+			expected := &ConfigExplanation{
+				Path:     "node.field",
+				Value:    3,
+				Supplier: "supplier3",
+				Priority: 20,
+				Overridden: []ConfigOrigin{
+					{Supplier: "supplier1", Priority: 0, Value: 1},
+				},
+			}
+
+			if check, e := sut.Explain("node.field"); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if !reflect.DeepEqual(check, expected) {
+				t.Errorf("(%v) when expecting (%v)", check, expected)
+			}
+		})
+
+		t.Run("explain a merged partial value by leaf", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": ConfigPartial{"a": 1, "b": 1}}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier1", 0, supplier1)
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": ConfigPartial{"b": 2}}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier2", 10, supplier2)
+
+			expected := &ConfigExplanation{
+				Path:   "node",
+				Value:  ConfigPartial{"a": 1, "b": 2},
+				Merged: true,
+				Leafs: []ConfigExplanation{
+					{Path: "node.a", Value: 1, Supplier: "supplier1", Priority: 0, Overridden: []ConfigOrigin{}},
+					{Path: "node.b", Value: 2, Supplier: "supplier2", Priority: 10, Overridden: []ConfigOrigin{
+						{Supplier: "supplier1", Priority: 0, Value: 1},
+					}},
+				},
+			}
+
+			check, e := sut.Explain("node")
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case !reflect.DeepEqual(check, expected):
+				t.Errorf("(%v) when expecting (%v)", check, expected)
+			case check.String() != "node = map[a:1 b:2] (merged)":
+				t.Errorf("rendered the (%v) explanation", check.String())
+			}
+		})
+
+		t.Run("explain a single supplier partial value", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": ConfigPartial{"a": 1}}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier1", 0, supplier1)
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": ConfigPartial{"a": 2}}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier2", 10, supplier2)
+
+			check, e := sut.Explain("node")
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case check.Merged:
+				t.Error("flagged a single supplier partial as merged")
+			case check.Supplier != "supplier2" || check.Priority != 10:
+				t.Errorf("explained the (%s:%d) supplier", check.Supplier, check.Priority)
+			case len(check.Overridden) != 1 || check.Overridden[0].Supplier != "supplier1":
+				t.Errorf("explained the (%v) overridden suppliers", check.Overridden)
+			case len(check.Leafs) != 1:
+				t.Errorf("explained (%d) leafs", len(check.Leafs))
+			}
+		})
+	})
+
+	t.Run("ExplainTo", func(t *testing.T) {
+		t.Run("nil writer", func(t *testing.T) {
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+
+			if e := sut.ExplainTo(nil); e == nil {
+				t.Errorf("didn't returned the expected error")
+			} else if !errors.Is(e, ErrNilPointer) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("render the whole tree provenance", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{"b": "value", "a": ConfigPartial{"c": 1}}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier1", 0, supplier1)
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"a": ConfigPartial{"c": 2}}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier2", 1, supplier2)
+
+			expected := "a.c = 2 (supplier2:1) overrides 1 (supplier1:0)\nb = value (supplier1:0)\n"
+			writer := &strings.Builder{}
+
+			if e := sut.ExplainTo(writer); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if check := writer.String(); check != expected {
+				t.Errorf("(%v) when expecting (%v)", check, expected)
+			}
+		})
+	})
+
+	t.Run("Export", func(t *testing.T) {
+		t.Run("invalid format", func(t *testing.T) {
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+
+			if _, e := sut.Export("invalid"); e == nil {
+				t.Errorf("didn't returned the expected error")
+			} else if !errors.Is(e, ErrInvalidConfigFormat) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrInvalidConfigFormat)
+			}
+		})
+
+		t.Run("invalid mask pattern", func(t *testing.T) {
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+
+			if _, e := sut.Export(ConfigFormatJSON, ConfigExportOptions{Mask: []string{"("}}); e == nil {
+				t.Errorf("didn't returned the expected error")
+			}
+		})
+
+		t.Run("export masked JSON", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{
+				"node": ConfigPartial{"b": 1, "a": []interface{}{"x", 2.5}, "password": "pass"},
+				"api":  ConfigPartial{"token": "tok"},
+			}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			expected := ConfigPartial{
+				"node": ConfigPartial{"b": 1, "a": []interface{}{"x", 2.5}, "password": "******"},
+				"api":  ConfigPartial{"token": "******"},
+			}
+
+			data, e := sut.Export(ConfigFormatJSON)
+			if e != nil {
+				t.Errorf("unexpected (%v) error", e)
+				return
+			}
+			again, _ := sut.Export(ConfigFormatJSON)
+			parser, _ := NewConfigJSONDecoder(strings.NewReader(string(data)))
+			partial, e := parser.Parse()
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case string(data) != string(again):
+				t.Error("didn't export with a deterministic key order")
+			case !reflect.DeepEqual(*partial, expected):
+				t.Errorf("(%v) when expecting (%v)", *partial, expected)
+			}
+		})
+
+		t.Run("export annotated YAML", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{
+				"node": ConfigPartial{"b": 1, "a": "value", "secret": "s"},
+			}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier1", 0, supplier1)
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{
+				"node": ConfigPartial{"b": 2},
+			}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier2", 1, supplier2)
+
+			expected := "node:\n  a: value # supplier1 (0)\n  b: 2 # supplier2 (1)\n  secret: '******' # supplier1 (0)\n"
+
+			data, e := sut.Export(ConfigFormatYAML, ConfigExportOptions{Mask: []string{"^secret$"}, Annotate: true})
+			if e != nil {
+				t.Errorf("unexpected (%v) error", e)
+				return
+			}
+			parser, _ := NewConfigYAMLDecoder(strings.NewReader(string(data)))
+			partial, e := parser.Parse()
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case string(data) != expected:
+				t.Errorf("(%v) when expecting (%v)", string(data), expected)
+			case !reflect.DeepEqual(*partial, ConfigPartial{"node": ConfigPartial{"a": "value", "b": 2, "secret": "******"}}):
+				t.Errorf("didn't export a parsable content : %v", *partial)
+			}
+		})
+	})
+
+	t.Run("HasObserver", func(t *testing.T) {
+		t.Run("check the existence of a observer", func(t *testing.T) {
+			scenarios := []struct {
+				observers []string
+				search    string
+				exp       bool
+			}{
+				{ // Search a non-existing path in an empty list of observers
+					observers: []string{},
+					search:    "node1",
+					exp:       false,
+				},
+				{ // Search a non-existing path in a non-empty list of observers
+					observers: []string{"node1", "node2"},
+					search:    "node3",
+					exp:       false,
+				},
+				{ // Search an existing path in a list of observers
+					observers: []string{"node1", "node2", "node3"},
+					search:    "node2",
+					exp:       true,
 				},
 			}
 
 			for _, s := range scenarios {
 				test := func() {
 					ctrl := gomock.NewController(t)
+					defer ctrl.Finish()
 
 					ConfigObserveFrequency = 0
 					sut := NewConfig()
+					defer func() { _ = sut.Close() }()
 					supplier := NewMockConfigSupplier(ctrl)
 					supplier.EXPECT().Close().Times(1)
-					supplier.EXPECT().Get("").Return(s.config, nil).Times(1)
-					_ = sut.AddSupplier("supplier", 0, supplier)
+					supplier.EXPECT().Get("").Return(ConfigPartial{
+						"node1": "value1",
+						"node2": "value2",
+						"node3": "value3",
+					}, nil).Times(1)
+					_ = sut.AddSupplier("config", 0, supplier)
 
-					defer func() { _ = sut.Close(); ctrl.Finish() }()
+					for _, observer := range s.observers {
+						_, _ = sut.AddObserver(observer, func(old, new interface{}) {})
+					}
+
+					if check := sut.HasObserver(s.search); check != s.exp {
+						t.Errorf("(%v) when expecting (%v)", check, s.exp)
+					}
+				}
+				test()
+			}
+		})
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		t.Run("override the supplier values and notify the observers", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value1"}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier", ConfigOverrideSupplierPriority, supplier)
+
+			var events []ConfigEvent
+			_, _ = sut.Observe("node", func(event ConfigEvent) { events = append(events, event) })
+
+			if e := sut.Set("node", "value2"); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if check, _ := sut.String("node"); check != "value2" {
+				t.Errorf("retrieved the (%v) value", check)
+			} else if len(events) != 1 || events[0].New != "value2" {
+				t.Errorf("notified the (%v) events", events)
+			} else if !sut.HasSupplier(ConfigOverrideSupplierID) {
+				t.Error("didn't registered the override supplier")
+			}
+		})
+
+		t.Run("keep the override on rebuild", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value1"}, nil).AnyTimes()
+			_ = sut.Set("node", "value2")
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if check, _ := sut.String("node"); check != "value2" {
+				t.Errorf("retrieved the (%v) value", check)
+			}
+		})
+
+		t.Run("error if the registered override supplier has an unexpected type", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).AnyTimes()
+			_ = sut.AddSupplier(ConfigOverrideSupplierID, 0, supplier)
+
+			if e := sut.Set("node", "value"); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrConversion) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrConversion)
+			}
+		})
 
-					if check := sut.Has(s.search); check != s.expected {
-						t.Errorf("(%v) when expecting (%v)", check, s.expected)
-					}
+		t.Run("discard the override if rejected", func(t *testing.T) {
+			expected := fmt.Errorf("error message")
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			_ = sut.Set("node", "value1")
+			_ = sut.AddValidator(func(partial ConfigPartial) error {
+				if partial.Has("other") {
+					return expected
 				}
-				test()
+				return nil
+			})
+
+			if e := sut.Set("other", "value"); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, expected) {
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			} else if sut.Has("other") {
+				t.Error("kept the rejected override")
 			}
 		})
 	})
 
-	t.Run("Get", func(t *testing.T) {
-		t.Run("return path value", func(t *testing.T) {
+	t.Run("Unset", func(t *testing.T) {
+		t.Run("error if the path is not overridden", func(t *testing.T) {
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+
+			if e := sut.Unset("node"); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrConfigPathNotFound) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrConfigPathNotFound)
+			}
+		})
+
+		t.Run("restore the supplier value", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			search := "node"
-			expected := "value"
-			config := ConfigPartial{search: expected}
-
 			ConfigObserveFrequency = 0
 			sut := NewConfig()
 			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Close().Times(1)
-			supplier.EXPECT().Get("").Return(config, nil).Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value1"}, nil).AnyTimes()
 			_ = sut.AddSupplier("supplier", 0, supplier)
+			_ = sut.Set("node", "value2")
 
-			defer func() { _ = sut.Close() }()
-
-			if check, e := sut.Get(search); e != nil {
+			if e := sut.Unset("node"); e != nil {
 				t.Errorf("unexpected (%v) error", e)
-			} else if check != expected {
-				t.Errorf("(%v) when expecting (%v)", check, expected)
+			} else if check, _ := sut.String("node"); check != "value1" {
+				t.Errorf("retrieved the (%v) value", check)
 			}
 		})
+	})
 
-		t.Run("return internal partial get error", func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
+	t.Run("PersistOverrides", func(t *testing.T) {
+		parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigYAMLDecoderCreator()})
 
-			data := ConfigPartial{"node1": ConfigPartial{"node2": 101}}
-			path := "node3"
+		t.Run("load and persist the override values", func(t *testing.T) {
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "overrides.yaml", []byte("node: value1"), 0o644)
 			ConfigObserveFrequency = 0
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
-			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Close().Times(1)
-			supplier.EXPECT().Get("").Return(data, nil).Times(1)
-			_ = sut.AddSupplier("supplier", 0, supplier)
 
-			check, e := sut.Get(path)
-			switch {
-			case check != nil:
-				t.Errorf("unexpected valid value : %v", check)
-			case e == nil:
+			if e := sut.PersistOverrides("overrides.yaml", fileSystem, parserFactory); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if check, _ := sut.String("node"); check != "value1" {
+				t.Errorf("retrieved the (%v) value", check)
+			} else if e := sut.Set("other", "value2"); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if content, _ := afero.ReadFile(fileSystem, "overrides.yaml"); string(content) != "node: value1\nother: value2\n" {
+				t.Errorf("persisted the (%s) content", content)
+			}
+		})
+	})
+
+	t.Run("Bind", func(t *testing.T) {
+		type data struct {
+			Host string
+			Port int
+		}
+
+		t.Run("nil data", func(t *testing.T) {
+			if _, e := NewConfig().Bind("node", nil); e == nil {
 				t.Error("didn't returned the expected error")
-			case !errors.Is(e, ErrConfigPathNotFound):
+			} else if !errors.Is(e, ErrNilPointer) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("non-pointer data", func(t *testing.T) {
+			if _, e := NewConfig().Bind("node", data{}); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrConversion) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrConversion)
+			}
+		})
+
+		t.Run("error if path not present", func(t *testing.T) {
+			if _, e := NewConfig().Bind("node", &data{}); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrConfigPathNotFound) {
 				t.Errorf("(%v) when expecting (%v)", e, ErrConfigPathNotFound)
 			}
 		})
 
-		t.Run("return simple if path was not found", func(t *testing.T) {
+		t.Run("error if the initial value is rejected", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			data := ConfigPartial{"node1": ConfigPartial{"node2": 101}}
-			path := "node3"
-			val := 3
+			expected := fmt.Errorf("error message")
 			ConfigObserveFrequency = 0
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
 			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Close().Times(1)
-			supplier.EXPECT().Get("").Return(data, nil).Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"node": ConfigPartial{"host": "localhost"}}, nil).Times(1)
 			_ = sut.AddSupplier("supplier", 0, supplier)
 
-			if check, e := sut.Get(path, val); e != nil {
-				t.Errorf("unexpected (%v) error", e)
-			} else if check != val {
-				t.Errorf("(%v) when expecting (%v)", check, val)
+			if _, e := sut.Bind("node", &data{}, ConfigBindOptions{
+				Validator: func(interface{}) error { return expected },
+			}); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, expected) {
+				t.Errorf("(%v) when expecting (%v)", e, expected)
 			}
 		})
-	})
 
-	t.Run("Bool", func(t *testing.T) {
-		t.Run("return the stored boolean value", func(t *testing.T) {
+		t.Run("populate the data and the initial bound value", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			path := "node"
 			ConfigObserveFrequency = 0
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
 			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Close().Times(1)
-			supplier.EXPECT().Get("").Return(ConfigPartial{path: true}, nil).Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"node": ConfigPartial{"host": "localhost"}}, nil).Times(1)
 			_ = sut.AddSupplier("supplier", 0, supplier)
 
-			if check, e := sut.Bool(path); e != nil {
+			target := data{Port: 80}
+			binding, e := sut.Bind("node", &target)
+			switch {
+			case e != nil:
 				t.Errorf("unexpected (%v) error", e)
-			} else if !check {
-				t.Errorf("returned (%v)", check)
+			case target != data{Host: "localhost", Port: 80}:
+				t.Errorf("populated (%v) data", target)
+			case binding.Load() != data{Host: "localhost", Port: 80}:
+				t.Errorf("bound the (%v) value", binding.Load())
 			}
 		})
-	})
 
-	t.Run("Int", func(t *testing.T) {
-		t.Run("return the stored integer value", func(t *testing.T) {
+		t.Run("refresh the bound value on changes", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			value := 123
-			path := "node"
 			ConfigObserveFrequency = 0
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
-			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Close().Times(1)
-			supplier.EXPECT().Get("").Return(ConfigPartial{path: value}, nil).Times(1)
+			supplier := NewMockConfigObsSupplier(ctrl)
+			gomock.InOrder(
+				supplier.EXPECT().Get("").Return(ConfigPartial{"node": ConfigPartial{"host": "localhost"}}, nil),
+				supplier.EXPECT().Get("").Return(ConfigPartial{"node": ConfigPartial{"host": "remote", "port": 8080}}, nil),
+			)
+			supplier.EXPECT().Reload().Return(true, nil).Times(1)
 			_ = sut.AddSupplier("supplier", 0, supplier)
 
-			if check, e := sut.Int(path); e != nil {
-				t.Errorf("unexpected (%v) error", e)
-			} else if check != value {
-				t.Errorf("returned (%v) when expecting : %v", check, value)
+			var changes []interface{}
+			binding, _ := sut.Bind("node", &data{Port: 80}, ConfigBindOptions{
+				OnChange: func(old, new interface{}) { changes = append(changes, old, new) },
+			})
+			_, _ = sut.Reload(context.Background())
+
+			expected := []interface{}{data{Host: "localhost", Port: 80}, data{Host: "remote", Port: 8080}}
+			switch {
+			case binding.Load() != data{Host: "remote", Port: 8080}:
+				t.Errorf("bound the (%v) value", binding.Load())
+			case !reflect.DeepEqual(changes, expected):
+				t.Errorf("notified the (%v) changes", changes)
 			}
 		})
-	})
 
-	t.Run("Float", func(t *testing.T) {
-		t.Run("return the stored integer value", func(t *testing.T) {
+		t.Run("keep the bound value if the refresh is rejected", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			value := 123.4
-			path := "node"
 			ConfigObserveFrequency = 0
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
-			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Close().Times(1)
-			supplier.EXPECT().Get("").Return(ConfigPartial{path: value}, nil).Times(1)
+			supplier := NewMockConfigObsSupplier(ctrl)
+			gomock.InOrder(
+				supplier.EXPECT().Get("").Return(ConfigPartial{"node": ConfigPartial{"port": 80}}, nil),
+				supplier.EXPECT().Get("").Return(ConfigPartial{"node": ConfigPartial{"port": "invalid"}}, nil),
+			)
+			supplier.EXPECT().Reload().Return(true, nil).Times(1)
 			_ = sut.AddSupplier("supplier", 0, supplier)
 
-			if check, e := sut.Float(path); e != nil {
-				t.Errorf("unexpected (%v) error", e)
-			} else if check != value {
-				t.Errorf("returned (%v) when expecting : %v", check, value)
+			var reported error
+			binding, _ := sut.Bind("node", &data{}, ConfigBindOptions{
+				ErrorHandler: func(e error) { reported = e },
+			})
+			_, _ = sut.Reload(context.Background())
+
+			switch {
+			case binding.Load() != data{Port: 80}:
+				t.Errorf("bound the (%v) value", binding.Load())
+			case !errors.Is(reported, ErrConversion):
+				t.Errorf("reported the (%v) error", reported)
 			}
 		})
-	})
 
-	t.Run("String", func(t *testing.T) {
-		t.Run("return the stored integer value", func(t *testing.T) {
+		t.Run("stop refreshing the bound value when closed", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			value := "value"
-			path := "node"
 			ConfigObserveFrequency = 0
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
-			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Close().Times(1)
-			supplier.EXPECT().Get("").Return(ConfigPartial{path: value}, nil).Times(1)
+			supplier := NewMockConfigObsSupplier(ctrl)
+			gomock.InOrder(
+				supplier.EXPECT().Get("").Return(ConfigPartial{"node": ConfigPartial{"port": 80}}, nil),
+				supplier.EXPECT().Get("").Return(ConfigPartial{"node": ConfigPartial{"port": 8080}}, nil),
+			)
+			supplier.EXPECT().Reload().Return(true, nil).Times(1)
 			_ = sut.AddSupplier("supplier", 0, supplier)
 
-			if check, e := sut.String(path); e != nil {
-				t.Errorf("unexpected (%v) error", e)
-			} else if check != value {
-				t.Errorf("returned (%v) when expecting : %v", check, value)
+			binding, _ := sut.Bind("node", &data{})
+			binding.Close()
+			_, _ = sut.Reload(context.Background())
+
+			if check := binding.Load(); check != (data{Port: 80}) {
+				t.Errorf("bound the (%v) value", check)
 			}
 		})
 	})
 
-	t.Run("List", func(t *testing.T) {
-		t.Run("return the stored integer value", func(t *testing.T) {
+	t.Run("AddObserver", func(t *testing.T) {
+		t.Run("nil callback", func(t *testing.T) {
+			ConfigObserveFrequency = 60
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+
+			if _, e := sut.AddObserver("path", nil); e == nil {
+				t.Errorf("didn't returned the expected error")
+			} else if !errors.Is(e, ErrNilPointer) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("error if path not present", func(t *testing.T) {
+			ConfigObserveFrequency = 60
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+
+			if _, e := sut.AddObserver("path", func(interface{}, interface{}) {
+			}); e == nil {
+				t.Errorf("didn't returned the expected error")
+			} else if !errors.Is(e, ErrConfigPathNotFound) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrConfigPathNotFound)
+			}
+		})
+
+		t.Run("valid callback", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			value := []interface{}{1, 2, 3}
-			path := "node"
-			ConfigObserveFrequency = 0
+			ConfigObserveFrequency = 60
+			partial := ConfigPartial{"path": "value"}
 			sut := NewConfig()
 			defer func() { _ = sut.Close() }()
 			supplier := NewMockConfigSupplier(ctrl)
 			supplier.EXPECT().Close().Times(1)
-			supplier.EXPECT().Get("").Return(ConfigPartial{path: value}, nil).Times(1)
-			_ = sut.AddSupplier("supplier", 0, supplier)
+			supplier.EXPECT().Get("").Return(partial, nil).Times(1)
+			_ = sut.AddSupplier("config", 0, supplier)
 
-			if check, e := sut.List(path); e != nil {
-				t.Errorf("unexpected (%v) error", e)
-			} else if !reflect.DeepEqual(check, value) {
-				t.Errorf("returned (%v) when expecting : %v", check, value)
+			if _, e := sut.AddObserver("path", func(interface{}, interface{}) {
+			}); e != nil {
+				t.Errorf("unexpected error, %v", e)
+			} else if len(sut.observers) != 1 {
+				t.Error("didn't stored the requested observer")
 			}
 		})
 	})
 
-	t.Run("Partial", func(t *testing.T) {
-		t.Run("return the stored partial value", func(t *testing.T) {
+	t.Run("RemoveObserver", func(t *testing.T) {
+		t.Run("remove a registered observer", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			value := ConfigPartial{"field": "value"}
-			path := "node"
-			ConfigObserveFrequency = 0
+			ConfigObserveFrequency = 60
 			sut := NewConfig()
 			defer func() { _ = sut.Close() }()
+
+			partial := ConfigPartial{
+				"node": ConfigPartial{
+					"1": "value1",
+					"2": "value2",
+					"3": "value3",
+				},
+			}
 			supplier := NewMockConfigSupplier(ctrl)
 			supplier.EXPECT().Close().Times(1)
-			supplier.EXPECT().Get("").Return(ConfigPartial{path: value}, nil).Times(1)
-			_ = sut.AddSupplier("supplier", 0, supplier)
+			supplier.EXPECT().Get("").Return(partial, nil).Times(1)
+			_ = sut.AddSupplier("config", 0, supplier)
 
-			if check, e := sut.Partial(path); e != nil {
-				t.Errorf("unexpected (%v) error", e)
-			} else if !reflect.DeepEqual(check, value) {
-				t.Errorf("returned (%v) when expecting : %v", check, value)
+			_, _ = sut.AddObserver("node.1", func(old, new interface{}) {})
+			_, _ = sut.AddObserver("node.2", func(old, new interface{}) {})
+			_, _ = sut.AddObserver("node.3", func(old, new interface{}) {})
+			sut.RemoveObserver("node.2")
+
+			if sut.HasObserver("node.2") {
+				t.Errorf("didn't removed the observer")
 			}
 		})
-	})
 
-	t.Run("Populate", func(t *testing.T) {
-		t.Run("populate the given structure", func(t *testing.T) {
+		t.Run("remove all the observers registered to a path", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			value := ConfigPartial{"field": ConfigPartial{"field": "value"}}
-			target := struct{ Field string }{}
-			expected := struct{ Field string }{Field: "value"}
-			path := "node"
-			ConfigObserveFrequency = 0
+			ConfigObserveFrequency = 60
 			sut := NewConfig()
 			defer func() { _ = sut.Close() }()
+
 			supplier := NewMockConfigSupplier(ctrl)
 			supplier.EXPECT().Close().Times(1)
-			supplier.EXPECT().Get("").Return(ConfigPartial{path: value}, nil).Times(1)
-			_ = sut.AddSupplier("supplier", 0, supplier)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value"}, nil).Times(1)
+			_ = sut.AddSupplier("config", 0, supplier)
 
-			if check, e := sut.Populate(path+"."+"field", target); e != nil {
-				t.Errorf("unexpected (%v) error", e)
-			} else if !reflect.DeepEqual(check, expected) {
-				t.Errorf("returned (%v) when expecting : %v", check, expected)
+			_, _ = sut.AddObserver("node", func(old, new interface{}) {})
+			_, _ = sut.AddObserver("node", func(old, new interface{}) {})
+			sut.RemoveObserver("node")
+
+			if sut.HasObserver("node") {
+				t.Errorf("didn't removed all the path observers")
 			}
 		})
-	})
 
-	t.Run("HasSupplier", func(t *testing.T) {
-		t.Run("validate if the supplier is registered", func(t *testing.T) {
+		t.Run("remove the observers case-insensitively on insensitive policy", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			ConfigObserveFrequency = 0
+			prev := ConfigCase
+			ConfigCase = ConfigCaseInsensitive
+			defer func() { ConfigCase = prev }()
+
+			ConfigObserveFrequency = 60
 			sut := NewConfig()
 			defer func() { _ = sut.Close() }()
+
 			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Close().Times(1)
-			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
-			_ = sut.AddSupplier("supplier", 0, supplier)
+			supplier.EXPECT().Close().Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"Node": "value"}, nil).Times(1)
+			_ = sut.AddSupplier("config", 0, supplier)
 
-			if !sut.HasSupplier("supplier") {
-				t.Error("returned false")
+			_, _ = sut.AddObserver("Node", func(old, new interface{}) {})
+			sut.RemoveObserver("node")
+
+			if sut.HasObserver("Node") {
+				t.Errorf("didn't removed the observer")
 			}
 		})
+	})
 
-		t.Run("invalidate if the supplier is not registered", func(t *testing.T) {
+	t.Run("RemoveObserverHandle", func(t *testing.T) {
+		t.Run("remove only the observer with the given handle", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			ConfigObserveFrequency = 0
+			ConfigObserveFrequency = 60
 			sut := NewConfig()
 			defer func() { _ = sut.Close() }()
+
 			supplier := NewMockConfigSupplier(ctrl)
 			supplier.EXPECT().Close().Times(1)
-			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
-			_ = sut.AddSupplier("supplier", 0, supplier)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value"}, nil).Times(1)
+			_ = sut.AddSupplier("config", 0, supplier)
 
-			if sut.HasSupplier("invalid supplier id") {
-				t.Error("returned true")
+			handle1, _ := sut.AddObserver("node", func(old, new interface{}) {})
+			handle2, _ := sut.AddObserver("node", func(old, new interface{}) {})
+			sut.RemoveObserverHandle(handle1)
+
+			switch {
+			case handle1 == handle2:
+				t.Error("returned the same handle for different registrations")
+			case len(sut.observers) != 1:
+				t.Errorf("stored (%d) observers when expecting 1", len(sut.observers))
+			case sut.observers[0].handle != handle2:
+				t.Error("removed the wrong observer")
 			}
 		})
 	})
 
-	t.Run("AddSupplier", func(t *testing.T) {
-		t.Run("nil supplier", func(t *testing.T) {
+	t.Run("Observe", func(t *testing.T) {
+		t.Run("nil callback", func(t *testing.T) {
 			ConfigObserveFrequency = 0
 			sut := NewConfig()
 			defer func() { _ = sut.Close() }()
 
-			if e := sut.AddSupplier("supplier", 0, nil); e == nil {
-				t.Error("didn't returned the expected error")
+			if _, e := sut.Observe("path", nil); e == nil {
+				t.Errorf("didn't returned the expected error")
 			} else if !errors.Is(e, ErrNilPointer) {
 				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
 			}
 		})
 
-		t.Run("register a new supplier", func(t *testing.T) {
+		t.Run("error if wildcard path prefix not present", func(t *testing.T) {
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			defer func() { _ = sut.Close() }()
+
+			if _, e := sut.Observe("path.*", func(ConfigEvent) {}); e == nil {
+				t.Errorf("didn't returned the expected error")
+			} else if !errors.Is(e, ErrConfigPathNotFound) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrConfigPathNotFound)
+			}
+		})
+
+		t.Run("notify the changed leaf paths of a observed partial", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
 			ConfigObserveFrequency = 0
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
-			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Close().Times(1)
-			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
 
-			if e := sut.AddSupplier("supplier", 0, supplier); e != nil {
-				t.Errorf("unexpected (%v) error", e)
-			} else if !sut.HasSupplier("supplier") {
-				t.Error("didn't stored the supplier")
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{
+				"node": ConfigPartial{"a": 1, "b": ConfigPartial{"c": 2}},
+			}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier1", 0, supplier1)
+
+			var events []ConfigEvent
+			_, _ = sut.Observe("node", func(event ConfigEvent) {
+				events = append(events, event)
+			})
+
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{
+				"node":  ConfigPartial{"b": ConfigPartial{"c": 3, "d": 4}},
+				"other": "value",
+			}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier2", 1, supplier2)
+
+			expected := []ConfigChange{
+				{Path: "node.b.c", Old: 2, New: 3},
+				{Path: "node.b.d", Old: nil, New: 4},
+			}
+			switch {
+			case len(events) != 1:
+				t.Errorf("called the callback (%d) times", len(events))
+			case events[0].Path != "node":
+				t.Errorf("notified the (%v) path", events[0].Path)
+			case !reflect.DeepEqual(events[0].Changes, expected):
+				t.Errorf("(%v) when expecting (%v)", events[0].Changes, expected)
 			}
 		})
 
-		t.Run("duplicate id", func(t *testing.T) {
+		t.Run("lowercase the observed path on lower policy", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
+			prev := ConfigCase
+			ConfigCase = ConfigCaseLower
+			defer func() { ConfigCase = prev }()
+
 			ConfigObserveFrequency = 0
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
-			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Close().Times(1)
-			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
-			_ = sut.AddSupplier("supplier", 0, supplier)
 
-			if e := sut.AddSupplier("supplier", 0, supplier); e == nil {
-				t.Error("didn't returned the expected error")
-			} else if !errors.Is(e, ErrDuplicateConfigSupplier) {
-				t.Errorf("(%v) when expecting (%v)", e, ErrDuplicateConfigSupplier)
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value"}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier1", 0, supplier1)
+
+			var events []ConfigEvent
+			if _, e := sut.Observe("NODE", func(event ConfigEvent) {
+				events = append(events, event)
+			}); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			}
+
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "other"}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier2", 1, supplier2)
+
+			switch {
+			case !sut.HasObserver("Node"):
+				t.Error("didn't found the observer")
+			case len(events) != 1:
+				t.Errorf("called the callback (%d) times", len(events))
+			case events[0].Path != "node":
+				t.Errorf("notified the (%v) path", events[0].Path)
 			}
 		})
 
-		t.Run("override path if the insert have higher priority", func(t *testing.T) {
+		t.Run("notify wildcard observers case-insensitively on insensitive policy", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
+			prev := ConfigCase
+			ConfigCase = ConfigCaseInsensitive
+			defer func() { ConfigCase = prev }()
+
 			ConfigObserveFrequency = 0
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
+
 			supplier1 := NewMockConfigSupplier(ctrl)
-			supplier1.EXPECT().Close().Times(1)
-			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value.1"}, nil).AnyTimes()
+			supplier1.EXPECT().Get("").Return(ConfigPartial{
+				"Headers": ConfigPartial{"Content-Type": "json"},
+			}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier1", 0, supplier1)
+
+			var events []ConfigEvent
+			if _, e := sut.Observe("headers.*", func(event ConfigEvent) {
+				events = append(events, event)
+			}); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			}
+
 			supplier2 := NewMockConfigSupplier(ctrl)
-			supplier2.EXPECT().Close().Times(1)
-			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "value.2"}, nil).AnyTimes()
-			_ = sut.AddSupplier("supplier.1", 1, supplier1)
-			_ = sut.AddSupplier("supplier.2", 2, supplier2)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{
+				"HEADERS": ConfigPartial{"content-type": "xml"},
+			}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier2", 1, supplier2)
 
-			if check, _ := sut.Get("node"); check != "value.2" {
-				t.Errorf("returned the (%v) value when expecting (value.2)", check)
+			switch {
+			case len(events) != 1:
+				t.Errorf("called the callback (%d) times", len(events))
+			case !reflect.DeepEqual(events[0].Changes, []ConfigChange{{Path: "Headers.Content-Type", Old: "json", New: "xml"}}):
+				t.Errorf("notified the (%v) changes", events[0].Changes)
 			}
 		})
 
-		t.Run("do not override path if the insert have lower priority", func(t *testing.T) {
+		t.Run("notify wildcard observers", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
 			ConfigObserveFrequency = 0
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
+
 			supplier1 := NewMockConfigSupplier(ctrl)
-			supplier1.EXPECT().Close().Times(1)
-			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value.1"}, nil).AnyTimes()
+			supplier1.EXPECT().Get("").Return(ConfigPartial{
+				"connections": ConfigPartial{
+					"primary":   ConfigPartial{"host": "localhost", "port": 1},
+					"secondary": ConfigPartial{"host": "localhost", "port": 2},
+				},
+			}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier1", 0, supplier1)
+
+			var hostEvents []ConfigEvent
+			_, _ = sut.Observe("connections.*.host", func(event ConfigEvent) {
+				hostEvents = append(hostEvents, event)
+			})
+			var allEvents []ConfigEvent
+			_, _ = sut.Observe("connections.**", func(event ConfigEvent) {
+				allEvents = append(allEvents, event)
+			})
+
 			supplier2 := NewMockConfigSupplier(ctrl)
-			supplier2.EXPECT().Close().Times(1)
-			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "value.2"}, nil).AnyTimes()
-			_ = sut.AddSupplier("supplier.1", 2, supplier1)
-			_ = sut.AddSupplier("supplier.2", 1, supplier2)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{
+				"connections": ConfigPartial{
+					"secondary": ConfigPartial{"port": 3},
+				},
+			}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier2", 1, supplier2)
 
-			if check, _ := sut.Get("node"); check != "value.1" {
-				t.Errorf("returned the (%v) value when expecting (value.1)", check)
+			supplier3 := NewMockConfigSupplier(ctrl)
+			supplier3.EXPECT().Get("").Return(ConfigPartial{
+				"connections": ConfigPartial{
+					"primary": ConfigPartial{"host": "remote"},
+				},
+			}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier3", 2, supplier3)
+
+			switch {
+			case len(hostEvents) != 1:
+				t.Errorf("called the host callback (%d) times", len(hostEvents))
+			case !reflect.DeepEqual(hostEvents[0].Changes, []ConfigChange{{Path: "connections.primary.host", Old: "localhost", New: "remote"}}):
+				t.Errorf("notified the (%v) changes", hostEvents[0].Changes)
+			case len(allEvents) != 2:
+				t.Errorf("called the prefix callback (%d) times", len(allEvents))
+			case !reflect.DeepEqual(allEvents[0].Changes, []ConfigChange{{Path: "connections.secondary.port", Old: 2, New: 3}}):
+				t.Errorf("notified the (%v) changes", allEvents[0].Changes)
 			}
 		})
 
-		t.Run("still be able to get not overridden paths of a inserted lower priority", func(t *testing.T) {
+		t.Run("observe quoted key and list index paths", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
 			ConfigObserveFrequency = 0
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
 			supplier1 := NewMockConfigSupplier(ctrl)
-			supplier1.EXPECT().Close().Times(1)
-			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value.1"}, nil).AnyTimes()
+			supplier1.EXPECT().Get("").Return(ConfigPartial{
+				"hosts": ConfigPartial{"db.internal": ConfigPartial{"port": 1}},
+				"items": []interface{}{"a"},
+			}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier.1", 0, supplier1)
+			var hostEvents, itemEvents []ConfigEvent
+			_, _ = sut.Observe(`hosts["db.internal"].*`, func(event ConfigEvent) {
+				hostEvents = append(hostEvents, event)
+			})
+			_, _ = sut.Observe("items[0]", func(event ConfigEvent) {
+				itemEvents = append(itemEvents, event)
+			})
 			supplier2 := NewMockConfigSupplier(ctrl)
-			supplier2.EXPECT().Close().Times(1)
-			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "value.2", "extendedNode": "extendedValue"}, nil).AnyTimes()
-			_ = sut.AddSupplier("supplier.1", 2, supplier1)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{
+				"hosts": ConfigPartial{"db.internal": ConfigPartial{"port": 2}},
+				"items": []interface{}{"b"},
+			}, nil).AnyTimes()
 			_ = sut.AddSupplier("supplier.2", 1, supplier2)
 
-			if check, _ := sut.Get("extendedNode"); check != "extendedValue" {
-				t.Errorf("returned the (%v) value when expecting (extendedValue)", check)
+			switch {
+			case len(hostEvents) != 1:
+				t.Errorf("called the host callback (%d) times", len(hostEvents))
+			case !reflect.DeepEqual(hostEvents[0].Changes, []ConfigChange{{Path: `hosts["db.internal"].port`, Old: 1, New: 2}}):
+				t.Errorf("notified the (%v) changes", hostEvents[0].Changes)
+			case len(itemEvents) != 1:
+				t.Errorf("called the item callback (%d) times", len(itemEvents))
+			case itemEvents[0].Old != "a" || itemEvents[0].New != "b":
+				t.Errorf("notified the (%v) event", itemEvents[0])
 			}
 		})
 	})
 
-	t.Run("RemoveSupplier", func(t *testing.T) {
-		t.Run("unregister a non-registered supplier", func(t *testing.T) {
+	t.Run("AddValidator", func(t *testing.T) {
+		t.Run("nil validator", func(t *testing.T) {
+			if e := NewConfig().AddValidator(nil); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrNilPointer) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("reject supplier addition if the validator fails", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			ConfigObserveFrequency = 0
+			expected := fmt.Errorf("error message")
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
+			_ = sut.AddValidator(func(partial ConfigPartial) error {
+				if partial["node"] == "invalid" {
+					return expected
+				}
+				return nil
+			})
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "valid"}, nil).Times(2)
+			_ = sut.AddSupplier("supplier.1", 0, supplier1)
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "invalid"}, nil).Times(1)
 
-			if e := sut.RemoveSupplier("supplier"); e != nil {
-				t.Errorf("unexpected error (%v)", e)
+			e := sut.AddSupplier("supplier.2", 1, supplier2)
+			switch {
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, expected):
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			case sut.HasSupplier("supplier.2"):
+				t.Error("didn't removed the rejected supplier")
+			}
+			if check, _ := sut.Get("node"); check != "valid" {
+				t.Errorf("returned (%v) when expecting (valid)", check)
 			}
 		})
 
-		t.Run("error unregister a previously registered supplier", func(t *testing.T) {
+		t.Run("reject supplier removal if the validator fails", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
 			expected := fmt.Errorf("error message")
-			ConfigObserveFrequency = 0
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
+			_ = sut.AddValidator(func(partial ConfigPartial) error {
+				if _, ok := partial["node"]; !ok {
+					return expected
+				}
+				return nil
+			})
 			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Close().Return(expected).Times(2)
-			supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value.1"}, nil).AnyTimes()
+			supplier.EXPECT().Close().Return(nil).Times(1)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value"}, nil).Times(1)
 			_ = sut.AddSupplier("supplier", 0, supplier)
 
-			if e := sut.RemoveSupplier("supplier"); e == nil {
+			e := sut.RemoveSupplier("supplier")
+			switch {
+			case e == nil:
 				t.Error("didn't returned the expected error")
-			} else if e.Error() != expected.Error() {
+			case !errors.Is(e, expected):
 				t.Errorf("(%v) when expecting (%v)", e, expected)
+			case !sut.HasSupplier("supplier"):
+				t.Error("removed the supplier")
 			}
 		})
+	})
 
-		t.Run("unregister a previously registered supplier", func(t *testing.T) {
+	t.Run("AddPrepareHook", func(t *testing.T) {
+		t.Run("nil hook", func(t *testing.T) {
+			if e := NewConfig().AddPrepareHook(nil); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrNilPointer) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("call the hook with the current and candidate partials", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			ConfigObserveFrequency = 0
+			var olds, news []ConfigPartial
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
-			supplier1 := NewMockConfigSupplier(ctrl)
-			supplier1.EXPECT().Close().Times(1)
-			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value.1"}, nil).AnyTimes()
-			supplier2 := NewMockConfigSupplier(ctrl)
-			supplier2.EXPECT().Close().Times(1)
-			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "value.2"}, nil).AnyTimes()
-			supplier3 := NewMockConfigSupplier(ctrl)
-			supplier3.EXPECT().Close().Times(1)
-			supplier3.EXPECT().Get("").Return(ConfigPartial{"node": "value.3"}, nil).AnyTimes()
-			_ = sut.AddSupplier("supplier.1", 0, supplier1)
-			_ = sut.AddSupplier("supplier.2", 0, supplier2)
-			_ = sut.AddSupplier("supplier.3", 0, supplier3)
-			_ = sut.RemoveSupplier("supplier.2")
+			_ = sut.AddPrepareHook(func(old, new ConfigPartial) error {
+				olds = append(olds, old)
+				news = append(news, new)
+				return nil
+			})
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value"}, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
 
-			if sut.HasSupplier("supplier.2") {
-				t.Error("didn't remove the supplier")
+			switch {
+			case len(olds) != 1:
+				t.Errorf("called the hook (%d) times", len(olds))
+			case !reflect.DeepEqual(olds[0], ConfigPartial{}):
+				t.Errorf("called the hook with the (%v) current partial", olds[0])
+			case !reflect.DeepEqual(news[0], ConfigPartial{"node": "value"}):
+				t.Errorf("called the hook with the (%v) candidate partial", news[0])
 			}
 		})
 
-		t.Run("recover path overridden by the removed supplier", func(t *testing.T) {
+		t.Run("don't notify observers if the hook fails", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			ConfigObserveFrequency = 0
+			expected := fmt.Errorf("error message")
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
 			supplier1 := NewMockConfigSupplier(ctrl)
-			supplier1.EXPECT().Close().Times(1)
-			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value.1"}, nil).AnyTimes()
-			supplier2 := NewMockConfigSupplier(ctrl)
-			supplier2.EXPECT().Close().Times(1)
-			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "value.2"}, nil).AnyTimes()
-			supplier3 := NewMockConfigSupplier(ctrl)
-			supplier3.EXPECT().Close().Times(1)
-			supplier3.EXPECT().Get("").Return(ConfigPartial{"node": "value.3"}, nil).AnyTimes()
+			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value1"}, nil).Times(2)
 			_ = sut.AddSupplier("supplier.1", 0, supplier1)
-			_ = sut.AddSupplier("supplier.2", 1, supplier2)
-			_ = sut.AddSupplier("supplier.3", 2, supplier3)
-			_ = sut.RemoveSupplier("supplier.3")
+			_ = sut.AddPrepareHook(func(_, _ ConfigPartial) error {
+				return expected
+			})
+			called := false
+			_, _ = sut.AddObserver("node", func(_, _ interface{}) {
+				called = true
+			})
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "value2"}, nil).Times(1)
 
-			if check, _ := sut.Get("node"); check != "value.2" {
-				t.Errorf("returned (%check) value when expecting (value.2)", check)
+			if e := sut.AddSupplier("supplier.2", 1, supplier2); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, expected) {
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			} else if called {
+				t.Error("called the observer")
+			} else if check, _ := sut.Get("node"); check != "value1" {
+				t.Errorf("returned (%v) when expecting (value1)", check)
 			}
 		})
-	})
 
-	t.Run("RemoveAllSuppliers", func(t *testing.T) {
-		t.Run("remove all the suppliers", func(t *testing.T) {
+		t.Run("abort the prepared hooks if a later hook fails", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			ConfigObserveFrequency = 0
+			expected := fmt.Errorf("error message")
+			var calls []string
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
+			_ = sut.AddPrepareHook(func(_, _ ConfigPartial) error {
+				calls = append(calls, "prepare 1")
+				return nil
+			}, func(_, new ConfigPartial) {
+				calls = append(calls, fmt.Sprintf("abort 1 %v", new["node"]))
+			})
+			_ = sut.AddPrepareHook(func(_, _ ConfigPartial) error {
+				calls = append(calls, "prepare 2")
+				return nil
+			})
+			_ = sut.AddPrepareHook(func(_, _ ConfigPartial) error {
+				calls = append(calls, "prepare 3")
+				return expected
+			}, func(_, _ ConfigPartial) {
+				calls = append(calls, "abort 3")
+			})
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value"}, nil).Times(1)
 
-			expected := fmt.Errorf("error string")
-			supplier1 := NewMockConfigSupplier(ctrl)
-			supplier1.EXPECT().Close().MinTimes(1)
-			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value.1"}, nil).AnyTimes()
-			supplier2 := NewMockConfigSupplier(ctrl)
-			supplier2.EXPECT().Close().MinTimes(1)
-			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "value.2"}, nil).AnyTimes()
-			supplier3 := NewMockConfigSupplier(ctrl)
-			supplier3.EXPECT().Close().Return(expected).MinTimes(1)
-			supplier3.EXPECT().Get("").Return(ConfigPartial{"node": "value.3"}, nil).AnyTimes()
-			_ = sut.AddSupplier("supplier.1", 0, supplier1)
-			_ = sut.AddSupplier("supplier.2", 1, supplier2)
-			_ = sut.AddSupplier("supplier.3", 2, supplier3)
+			if e := sut.AddSupplier("supplier", 0, supplier); !errors.Is(e, expected) {
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			} else if !reflect.DeepEqual(calls, []string{"prepare 1", "prepare 2", "prepare 3", "abort 1 value"}) {
+				t.Errorf("(%v) calls", calls)
+			}
+		})
+	})
 
-			if e := sut.RemoveAllSuppliers(); e == nil {
+	t.Run("AddErrorHandler", func(t *testing.T) {
+		t.Run("nil handler", func(t *testing.T) {
+			if e := NewConfig().AddErrorHandler(nil); e == nil {
 				t.Error("didn't returned the expected error")
-			} else if e.Error() != expected.Error() {
-				t.Errorf("(%v) when expecting (%v)", e, expected)
+			} else if !errors.Is(e, ErrNilPointer) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("store the handler", func(t *testing.T) {
+			sut := NewConfig()
+			if e := sut.AddErrorHandler(func(error) {}); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if len(sut.handlers) != 1 {
+				t.Errorf("stored (%d) handlers", len(sut.handlers))
 			}
 		})
+	})
 
-		t.Run("remove all the suppliers", func(t *testing.T) {
+	t.Run("History", func(t *testing.T) {
+		t.Run("record the committed revisions", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			ConfigObserveFrequency = 0
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
-
 			supplier1 := NewMockConfigSupplier(ctrl)
-			supplier1.EXPECT().Close().Times(1)
-			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value.1"}, nil).AnyTimes()
+			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value 1"}, nil).AnyTimes()
 			supplier2 := NewMockConfigSupplier(ctrl)
-			supplier2.EXPECT().Close().Times(1)
-			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "value.2"}, nil).AnyTimes()
+			supplier2.EXPECT().Get("").Return(ConfigPartial{}, nil).AnyTimes()
 			supplier3 := NewMockConfigSupplier(ctrl)
-			supplier3.EXPECT().Close().Times(1)
-			supplier3.EXPECT().Get("").Return(ConfigPartial{"node": "value.3"}, nil).AnyTimes()
-			_ = sut.AddSupplier("supplier.1", 0, supplier1)
-			_ = sut.AddSupplier("supplier.2", 1, supplier2)
-			_ = sut.AddSupplier("supplier.3", 2, supplier3)
-			_ = sut.RemoveAllSuppliers()
+			supplier3.EXPECT().Get("").Return(ConfigPartial{"node": "value 2", "db": ConfigPartial{"password": "secret"}}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier1", 0, supplier1)
+			_ = sut.AddSupplier("supplier2", 1, supplier2)
+			_ = sut.AddSupplier("supplier3", 2, supplier3)
 
-			if len(sut.suppliers) != 0 {
-				t.Error("didn't removed all the registered suppliers")
+			history := sut.History()
+			switch {
+			case sut.Revision() != 2:
+				t.Errorf("(%d) when expecting (2)", sut.Revision())
+			case len(history) != 2:
+				t.Errorf("recorded (%d) revisions", len(history))
+			case history[0].Revision != 1 || history[0].Supplier != "supplier1" || history[0].Timestamp.IsZero():
+				t.Errorf("recorded the (%v) revision", history[0])
+			case !reflect.DeepEqual(history[0].Changes, []ConfigChange{{Path: "node", Old: nil, New: "value 1"}}):
+				t.Errorf("recorded the (%v) changes", history[0].Changes)
+			case history[1].Revision != 2 || history[1].Supplier != "supplier3":
+				t.Errorf("recorded the (%v) revision", history[1])
+			case !reflect.DeepEqual(history[1].Changes, []ConfigChange{
+				{Path: "db.password", Old: nil, New: ConfigExportMaskValue},
+				{Path: "node", Old: "value 1", New: "value 2"},
+			}):
+				t.Errorf("recorded the (%v) changes", history[1].Changes)
 			}
 		})
-	})
 
-	t.Run("Supplier", func(t *testing.T) {
-		t.Run("error if the supplier don't exists", func(t *testing.T) {
+		t.Run("record the reloaded suppliers", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			ConfigObserveFrequency = 0
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
+			supplier := NewMockConfigObsSupplier(ctrl)
+			gomock.InOrder(
+				supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value 1"}, nil),
+				supplier.EXPECT().Reload().Return(true, nil),
+				supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value 2"}, nil),
+			)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+			_, _ = sut.Reload(context.Background())
 
-			check, e := sut.Supplier("invalid id")
-			switch {
-			case check != nil:
-				t.Error("returned a valid reference")
-			case e == nil:
-				t.Error("didn't returned the expected error")
-			case !errors.Is(e, ErrConfigSupplierNotFound):
-				t.Errorf("(%v) when expecting (%v)", e, ErrConfigSupplierNotFound)
+			if history := sut.History(); len(history) != 2 {
+				t.Errorf("recorded (%d) revisions", len(history))
+			} else if history[1].Supplier != "supplier" {
+				t.Errorf("recorded the (%v) triggering supplier", history[1].Supplier)
 			}
 		})
 
-		t.Run("return the registered supplier", func(t *testing.T) {
+		t.Run("bound the stored history", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			ConfigObserveFrequency = 0
+			prev := ConfigHistorySize
+			ConfigHistorySize = 2
+			defer func() { ConfigHistorySize = prev }()
+
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
-			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Close().Times(1)
-			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
-			_ = sut.AddSupplier("supplier", 0, supplier)
+			for i := 1; i <= 3; i++ {
+				supplier := NewMockConfigSupplier(ctrl)
+				supplier.EXPECT().Get("").Return(ConfigPartial{"node": i}, nil).AnyTimes()
+				_ = sut.AddSupplier(fmt.Sprintf("supplier%d", i), i, supplier)
+			}
 
-			check, e := sut.Supplier("supplier")
-			switch {
-			case e != nil:
-				t.Errorf("unexpected (%v) error", e)
-			case check == nil:
-				t.Error("returned nil")
-			case !reflect.DeepEqual(check, supplier):
-				t.Errorf("(%v) when expecting (%v)", check, supplier)
+			if history := sut.History(); len(history) != 2 {
+				t.Errorf("recorded (%d) revisions", len(history))
+			} else if history[0].Revision != 2 || history[1].Revision != 3 {
+				t.Errorf("kept the (%v) revisions", history)
 			}
 		})
 	})
 
-	t.Run("SupplierPriority", func(t *testing.T) {
-		t.Run("error if the supplier was not found", func(t *testing.T) {
-			ConfigObserveFrequency = 0
-			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
-
-			if e := sut.SupplierPriority("invalid id", 0); e == nil {
-				t.Error("didn't returned the expected error")
-			} else if !errors.Is(e, ErrConfigSupplierNotFound) {
-				t.Errorf("(%v) when expecting (%v)", e, ErrConfigSupplierNotFound)
-			}
-		})
+	t.Run("Diff", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-		t.Run("update the priority of the supplier", func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
+		prev := ConfigHistorySize
+		ConfigHistorySize = 3
+		defer func() { ConfigHistorySize = prev }()
 
-			ConfigObserveFrequency = 0
-			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
-			supplier1 := NewMockConfigSupplier(ctrl)
-			supplier1.EXPECT().Close().Times(1)
-			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": "value.1"}, nil).AnyTimes()
-			supplier2 := NewMockConfigSupplier(ctrl)
-			supplier2.EXPECT().Close().Times(1)
-			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "value.2"}, nil).AnyTimes()
-			_ = sut.AddSupplier("supplier.1", 1, supplier1)
-			_ = sut.AddSupplier("supplier.2", 2, supplier2)
+		sut := NewConfig()
+		contents := []ConfigPartial{
+			{"node1": "value 1"},
+			{"node1": "value 2", "node2": "value 1"},
+			{"node1": "value 1", "node2": "value 2"},
+			{"node3": "value 1"},
+		}
+		for i, content := range contents {
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(content, nil).AnyTimes()
+			_ = sut.AddSupplier(fmt.Sprintf("supplier%d", i), i, supplier)
+		}
 
-			if check, _ := sut.Get("node"); check != "value.2" {
-				t.Errorf("returned the (%v) value prior the change, when expecting (value.2)", check)
+		t.Run("error on revision out of the history", func(t *testing.T) {
+			if _, e := sut.Diff(0, 4); !errors.Is(e, ErrConfigRevisionNotFound) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrConfigRevisionNotFound)
 			}
-			if e := sut.SupplierPriority("supplier.2", 0); e != nil {
-				t.Errorf("returned the unexpeced error : (%v)", e)
-			}
-			if check, _ := sut.Get("node"); check != "value.1" {
-				t.Errorf("returned the (%v) value after the change, when expecting (value.1)", check)
+			if _, e := sut.Diff(1, 5); !errors.Is(e, ErrConfigRevisionNotFound) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrConfigRevisionNotFound)
 			}
 		})
-	})
 
-	t.Run("HasObserver", func(t *testing.T) {
-		t.Run("check the existence of a observer", func(t *testing.T) {
-			scenarios := []struct {
-				observers []string
-				search    string
-				exp       bool
-			}{
-				{ // Search a non-existing path in an empty list of observers
-					observers: []string{},
-					search:    "node1",
-					exp:       false,
-				},
-				{ // Search a non-existing path in a non-empty list of observers
-					observers: []string{"node1", "node2"},
-					search:    "node3",
-					exp:       false,
-				},
-				{ // Search an existing path in a list of observers
-					observers: []string{"node1", "node2", "node3"},
-					search:    "node2",
-					exp:       true,
-				},
+		t.Run("compose the revisions changes", func(t *testing.T) {
+			expected := []ConfigChange{
+				{Path: "node2", Old: nil, New: "value 2"},
+				{Path: "node3", Old: nil, New: "value 1"},
 			}
+			if check, e := sut.Diff(1, 4); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if !reflect.DeepEqual(check, expected) {
+				t.Errorf("(%v) when expecting (%v)", check, expected)
+			}
+		})
 
-			for _, s := range scenarios {
-				test := func() {
-					ctrl := gomock.NewController(t)
-					defer ctrl.Finish()
-
-					ConfigObserveFrequency = 0
-					sut := NewConfig()
-					defer func() { _ = sut.Close() }()
-					supplier := NewMockConfigSupplier(ctrl)
-					supplier.EXPECT().Close().Times(1)
-					supplier.EXPECT().Get("").Return(ConfigPartial{
-						"node1": "value1",
-						"node2": "value2",
-						"node3": "value3",
-					}, nil).Times(1)
-					_ = sut.AddSupplier("config", 0, supplier)
-
-					for _, observer := range s.observers {
-						_ = sut.AddObserver(observer, func(old, new interface{}) {})
-					}
-
-					if check := sut.HasObserver(s.search); check != s.exp {
-						t.Errorf("(%v) when expecting (%v)", check, s.exp)
-					}
-				}
-				test()
+		t.Run("revert the revisions changes", func(t *testing.T) {
+			expected := []ConfigChange{
+				{Path: "node1", Old: "value 1", New: "value 2"},
+				{Path: "node2", Old: "value 2", New: "value 1"},
+			}
+			if check, e := sut.Diff(3, 2); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if !reflect.DeepEqual(check, expected) {
+				t.Errorf("(%v) when expecting (%v)", check, expected)
 			}
 		})
 	})
 
-	t.Run("AddObserver", func(t *testing.T) {
-		t.Run("nil callback", func(t *testing.T) {
-			ConfigObserveFrequency = 60
+	t.Run("SetExportMask", func(t *testing.T) {
+		t.Run("invalid mask pattern", func(t *testing.T) {
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
-
-			if e := sut.AddObserver("path", nil); e == nil {
+			if e := sut.SetExportMask([]string{"("}); e == nil {
 				t.Errorf("didn't returned the expected error")
-			} else if !errors.Is(e, ErrNilPointer) {
-				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			} else if !reflect.DeepEqual(sut.mask, ConfigExportMask) {
+				t.Errorf("stored the (%v) mask patterns", sut.mask)
 			}
 		})
 
-		t.Run("error if path not present", func(t *testing.T) {
-			ConfigObserveFrequency = 60
+		t.Run("mask the export, history and diff with the configured patterns", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
+			if e := sut.SetExportMask([]string{"^pin$"}); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			}
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{"pin": 1, "password": "pass"}, nil).AnyTimes()
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"pin": 2}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier1", 0, supplier1)
+			_ = sut.AddSupplier("supplier2", 1, supplier2)
 
-			if e := sut.AddObserver("path", func(interface{}, interface{}) {
-			}); e == nil {
-				t.Errorf("didn't returned the expected error")
-			} else if !errors.Is(e, ErrConfigPathNotFound) {
-				t.Errorf("(%v) when expecting (%v)", e, ErrConfigPathNotFound)
+			exported, _ := sut.Export(ConfigFormatJSON)
+			history := sut.History()
+			diff, e := sut.Diff(0, 2)
+			switch {
+			case e != nil:
+				t.Errorf("unexpected (%v) error", e)
+			case string(exported) != "{\n  \"password\": \"pass\",\n  \"pin\": \"******\"\n}":
+				t.Errorf("exported (%s)", exported)
+			case len(history) != 2:
+				t.Errorf("recorded (%d) revisions", len(history))
+			case !reflect.DeepEqual(history[1].Changes, []ConfigChange{{Path: "pin", Old: ConfigExportMaskValue, New: ConfigExportMaskValue}}):
+				t.Errorf("recorded the (%v) changes", history[1].Changes)
+			case !reflect.DeepEqual(diff, []ConfigChange{
+				{Path: "password", Old: nil, New: "pass"},
+				{Path: "pin", Old: nil, New: ConfigExportMaskValue},
+			}):
+				t.Errorf("(%v) diff", diff)
 			}
 		})
+	})
 
-		t.Run("valid callback", func(t *testing.T) {
+	t.Run("AddRevisionHandler", func(t *testing.T) {
+		t.Run("nil handler", func(t *testing.T) {
+			if e := NewConfig().AddRevisionHandler(nil); !errors.Is(e, ErrNilPointer) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("notify the committed revisions", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			ConfigObserveFrequency = 60
-			partial := ConfigPartial{"path": "value"}
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
+			var revisions []ConfigRevision
+			_ = sut.AddRevisionHandler(func(revision ConfigRevision) {
+				revisions = append(revisions, revision)
+			})
 			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Close().Times(1)
-			supplier.EXPECT().Get("").Return(partial, nil).Times(1)
-			_ = sut.AddSupplier("config", 0, supplier)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value"}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier", 0, supplier)
 
-			if e := sut.AddObserver("path", func(interface{}, interface{}) {
-			}); e != nil {
-				t.Errorf("unexpected error, %v", e)
-			} else if len(sut.observers) != 1 {
-				t.Error("didn't stored the requested observer")
+			if len(revisions) != 1 {
+				t.Errorf("notified (%d) revisions", len(revisions))
+			} else if !reflect.DeepEqual(revisions[0], sut.History()[0]) {
+				t.Errorf("notified the (%v) revision", revisions[0])
 			}
 		})
 	})
 
-	t.Run("RemoveObserver", func(t *testing.T) {
-		t.Run("remove a registered observer", func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
+	t.Run("AddWarningHandler", func(t *testing.T) {
+		t.Run("nil handler", func(t *testing.T) {
+			if e := NewConfig().AddWarningHandler(nil); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrNilPointer) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
 
-			ConfigObserveFrequency = 60
+		t.Run("report recorded and future warnings", func(t *testing.T) {
+			warning1 := fmt.Errorf("warning 1")
+			warning2 := fmt.Errorf("warning 2")
 			sut := NewConfig()
-			defer func() { _ = sut.Close() }()
+			sut.warn(warning1)
 
-			partial := ConfigPartial{
-				"node": ConfigPartial{
-					"1": "value1",
-					"2": "value2",
-					"3": "value3",
-				},
+			var reported []error
+			if e := sut.AddWarningHandler(func(e error) { reported = append(reported, e) }); e != nil {
+				t.Errorf("unexpected (%v) error", e)
 			}
-			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Close().Times(1)
-			supplier.EXPECT().Get("").Return(partial, nil).Times(1)
-			_ = sut.AddSupplier("config", 0, supplier)
+			sut.warn(warning2)
 
-			_ = sut.AddObserver("node.1", func(old, new interface{}) {})
-			_ = sut.AddObserver("node.2", func(old, new interface{}) {})
-			_ = sut.AddObserver("node.3", func(old, new interface{}) {})
-			sut.RemoveObserver("node.2")
-
-			if sut.HasObserver("node.2") {
-				t.Errorf("didn't removed the observer")
+			expected := []error{warning1, warning2}
+			switch {
+			case !reflect.DeepEqual(reported, expected):
+				t.Errorf("reported (%v) when expecting (%v)", reported, expected)
+			case !reflect.DeepEqual(sut.Warnings(), expected):
+				t.Errorf("recorded (%v) when expecting (%v)", sut.Warnings(), expected)
 			}
 		})
 	})
@@ -7065,7 +11576,7 @@ func Test_Config(t *testing.T) {
 			supplier1.EXPECT().Get("").Return(partial, nil).AnyTimes()
 			_ = sut.AddSupplier("supplier1", 0, supplier1)
 
-			_ = sut.AddObserver("node", func(old, new interface{}) {
+			_, _ = sut.AddObserver("node", func(old, new interface{}) {
 				check = true
 
 				if old != "value1" {
@@ -7100,7 +11611,7 @@ func Test_Config(t *testing.T) {
 			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": initial}, nil).AnyTimes()
 			_ = sut.AddSupplier("supplier1", 0, supplier1)
 
-			_ = sut.AddObserver("node", func(old, new interface{}) {
+			_, _ = sut.AddObserver("node", func(old, new interface{}) {
 				check = true
 
 				if old.([]interface{})[0].(ConfigPartial)["sub_node"] != initial[0].(ConfigPartial)["sub_node"] {
@@ -7135,24 +11646,96 @@ func Test_Config(t *testing.T) {
 			supplier1.EXPECT().Get("").Return(ConfigPartial{"node": initial}, nil).AnyTimes()
 			_ = sut.AddSupplier("supplier1", 0, supplier1)
 
-			_ = sut.AddObserver("node", func(old, new interface{}) {
+			_, _ = sut.AddObserver("node", func(old, new interface{}) {
 				check = true
 
-				if reflect.DeepEqual(old, initial) {
+				if !reflect.DeepEqual(old, initial) {
 					t.Errorf("callback called with (%v) as old value", old)
-				} else if reflect.DeepEqual(old, expected) {
+				} else if !reflect.DeepEqual(new, expected) {
 					t.Errorf("callback called with (%v) as new value", new)
 				}
 			})
 
-			supplier2 := NewMockConfigSupplier(ctrl)
-			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": expected}, nil).AnyTimes()
-			_ = sut.AddSupplier("supplier2", 1, supplier2)
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": expected}, nil).AnyTimes()
+			_ = sut.AddSupplier("supplier2", 1, supplier2)
+
+			if !check {
+				t.Errorf("didn't actually called the callback")
+			} else if check := sut.observers[0].current; check.(ConfigPartial)["sub_node"] != expected["sub_node"] {
+				t.Errorf("stored {%v} instead of {%v}", check, expected)
+			}
+		})
+
+		t.Run("report observable supplier reload errors", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := fmt.Errorf("error message")
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			var reported []error
+			_ = sut.AddErrorHandler(func(e error) {
+				reported = append(reported, e)
+			})
+
+			supplier := NewMockConfigObsSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{"node": "value"}, nil).Times(1)
+			supplier.EXPECT().Reload().Return(false, expected).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+
+			if e := sut.reload(); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, expected) {
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			} else if len(reported) != 1 || !errors.Is(reported[0], expected) {
+				t.Errorf("reported (%v) errors", reported)
+			}
+		})
+
+		t.Run("rollback a rejected reload", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := fmt.Errorf("error message")
+			ConfigObserveFrequency = 0
+			sut := NewConfig()
+			var reported []error
+			_ = sut.AddErrorHandler(func(e error) {
+				reported = append(reported, e)
+			})
+			_ = sut.AddValidator(func(partial ConfigPartial) error {
+				if partial["node"] == "invalid" {
+					return expected
+				}
+				return nil
+			})
+
+			supplier := NewMockConfigObsSupplier(ctrl)
+			gomock.InOrder(
+				supplier.EXPECT().Get("").Return(ConfigPartial{"node": "valid"}, nil),
+				supplier.EXPECT().Get("").Return(ConfigPartial{"node": "invalid"}, nil),
+			)
+			supplier.EXPECT().Reload().Return(true, nil).Times(1)
+			_ = sut.AddSupplier("supplier", 0, supplier)
+			called := false
+			_, _ = sut.AddObserver("node", func(_, _ interface{}) {
+				called = true
+			})
 
-			if !check {
-				t.Errorf("didn't actually called the callback")
-			} else if check := sut.observers[0].current; check.(ConfigPartial)["sub_node"] != expected["sub_node"] {
-				t.Errorf("stored {%v} instead of {%v}", check, expected)
+			e := sut.reload()
+			switch {
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, expected):
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			case len(reported) != 1:
+				t.Errorf("reported (%v) errors", reported)
+			case called:
+				t.Error("called the observer")
+			}
+			if check, _ := sut.Get("node"); check != "valid" {
+				t.Errorf("returned (%v) when expecting (valid)", check)
 			}
 		})
 	})
@@ -7208,56 +11791,400 @@ func Test_ConfigLoader(t *testing.T) {
 			"format": "format",
 		}
 
-		t.Run("error getting the base supplier", func(t *testing.T) {
+		t.Run("error getting the base supplier", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := fmt.Errorf("error message")
+			supplierCreator := NewMockConfigSupplierCreator(ctrl)
+			supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true).Times(1)
+			supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(nil, expected).Times(1)
+			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
+
+			sut, _ := NewConfigLoader(NewConfig(), supplierFactory)
+
+			if e := sut.Load(); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if e.Error() != expected.Error() {
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			}
+		})
+
+		t.Run("error storing the base supplier", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
+			supplierCreator := NewMockConfigSupplierCreator(ctrl)
+			supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true).Times(1)
+			supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier, nil).Times(1)
+			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
+			config := NewConfig()
+			_ = config.AddSupplier(ConfigLoaderSupplierID, 0, supplier)
+
+			sut, _ := NewConfigLoader(config, supplierFactory)
+
+			if e := sut.Load(); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrDuplicateConfigSupplier) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrDuplicateConfigSupplier)
+			}
+		})
+
+		t.Run("add base supplier into the partial", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
+			supplierCreator := NewMockConfigSupplierCreator(ctrl)
+			supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true).Times(1)
+			supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier, nil).Times(1)
+			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
+			config := NewConfig()
+
+			sut, _ := NewConfigLoader(config, supplierFactory)
+
+			if e := sut.Load(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if len(config.suppliers) == 0 {
+				t.Error("didn't stored the base supplier")
+			}
+		})
+
+		t.Run("invalid list of suppliers results in an empty suppliers list", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			partial := ConfigPartial{}
+			_, _ = partial.Set("slate.config.suppliers", "string")
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(partial, nil).Times(1)
+			supplierCreator := NewMockConfigSupplierCreator(ctrl)
+			supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true).Times(1)
+			supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier, nil).Times(1)
+			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
+
+			sut, _ := NewConfigLoader(NewConfig(), supplierFactory)
+
+			if e := sut.Load(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			}
+		})
+
+		t.Run("error parsing supplier entry", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			entry := ConfigPartial{"priority": "string"}
+			partial := ConfigPartial{}
+			_, _ = partial.Set("slate.config.suppliers", ConfigPartial{"supplier": entry})
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(partial, nil).Times(1)
+			supplierCreator := NewMockConfigSupplierCreator(ctrl)
+			supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true).Times(1)
+			supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier, nil).Times(1)
+			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
+
+			sut, _ := NewConfigLoader(NewConfig(), supplierFactory)
+
+			if e := sut.Load(); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrConversion) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrConversion)
+			}
+		})
+
+		t.Run("error creating the supplier entry", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := fmt.Errorf("error message")
+			entry := ConfigPartial{"type": "my type"}
+			partial := ConfigPartial{}
+			_, _ = partial.Set("slate.config.suppliers", ConfigPartial{"supplier": entry})
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(partial, nil).Times(1)
+			supplierCreator := NewMockConfigSupplierCreator(ctrl)
+			gomock.InOrder(
+				supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true),
+				supplierCreator.EXPECT().Accept(&entry).Return(true),
+			)
+			gomock.InOrder(
+				supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier, nil),
+				supplierCreator.EXPECT().Create(&entry).Return(nil, expected),
+			)
+			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
+
+			sut, _ := NewConfigLoader(NewConfig(), supplierFactory)
+
+			if e := sut.Load(); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if e.Error() != expected.Error() {
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			}
+		})
+
+		t.Run("error on supplier registration", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			supplierEntry := ConfigPartial{"type": "my type"}
+			suppliers := ConfigPartial{}
+			_, _ = suppliers.Set("slate.config.suppliers", ConfigPartial{"supplier": supplierEntry})
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(suppliers, nil).Times(1)
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(supplierEntry, nil).Times(2)
+			supplierCreator := NewMockConfigSupplierCreator(ctrl)
+			gomock.InOrder(
+				supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true),
+				supplierCreator.EXPECT().Accept(&supplierEntry).Return(true),
+			)
+			gomock.InOrder(
+				supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier1, nil),
+				supplierCreator.EXPECT().Create(&supplierEntry).Return(supplier2, nil),
+			)
+			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
+			config := NewConfig()
+			_ = config.AddSupplier("supplier", 0, supplier2)
+
+			sut, _ := NewConfigLoader(config, supplierFactory)
+
+			if e := sut.Load(); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrDuplicateConfigSupplier) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrDuplicateConfigSupplier)
+			}
+		})
+
+		t.Run("register the loaded supplier", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			supplierEntry := ConfigPartial{"type": "my type"}
+			suppliers := ConfigPartial{}
+			_, _ = suppliers.Set("slate.config.suppliers", ConfigPartial{"supplier": supplierEntry})
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(suppliers, nil).Times(2)
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(supplierEntry, nil).Times(1)
+			supplierCreator := NewMockConfigSupplierCreator(ctrl)
+			gomock.InOrder(
+				supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true),
+				supplierCreator.EXPECT().Accept(&supplierEntry).Return(true),
+			)
+			gomock.InOrder(
+				supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier1, nil),
+				supplierCreator.EXPECT().Create(&supplierEntry).Return(supplier2, nil),
+			)
+			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
+			config := NewConfig()
+
+			sut, _ := NewConfigLoader(config, supplierFactory)
+
+			if e := sut.Load(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			}
+		})
+
+		t.Run("error on invalid supplier merge strategy", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			supplierEntry := ConfigPartial{"type": "my type", "merge": []interface{}{ConfigPartial{"path": "list", "strategy": "invalid"}}}
+			suppliers := ConfigPartial{}
+			_, _ = suppliers.Set("slate.config.suppliers", ConfigPartial{"supplier": supplierEntry})
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(suppliers, nil).Times(1)
+			supplierCreator := NewMockConfigSupplierCreator(ctrl)
+			supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true)
+			supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier1, nil)
+			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
+
+			sut, _ := NewConfigLoader(NewConfig(), supplierFactory)
+
+			if e := sut.Load(); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, ErrInvalidConfigMergeStrategy) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrInvalidConfigMergeStrategy)
+			}
+		})
+
+		t.Run("register the loaded supplier with the merge strategies", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			supplierEntry := ConfigPartial{
+				"type":     "my type",
+				"priority": 1,
+				"merge":    []interface{}{ConfigPartial{"path": "list", "strategy": ConfigMergeAppend}},
+			}
+			suppliers := ConfigPartial{"list": []interface{}{1}}
+			_, _ = suppliers.Set("slate.config.suppliers", ConfigPartial{"supplier": supplierEntry})
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(suppliers, nil).AnyTimes()
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"list": []interface{}{2}}, nil).AnyTimes()
+			supplierCreator := NewMockConfigSupplierCreator(ctrl)
+			gomock.InOrder(
+				supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true),
+				supplierCreator.EXPECT().Accept(&supplierEntry).Return(true),
+			)
+			gomock.InOrder(
+				supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier1, nil),
+				supplierCreator.EXPECT().Create(&supplierEntry).Return(supplier2, nil),
+			)
+			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
+			config := NewConfig()
+
+			sut, _ := NewConfigLoader(config, supplierFactory)
+
+			if e := sut.Load(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if check, _ := config.List("list"); !reflect.DeepEqual(check, []interface{}{1, 2}) {
+				t.Errorf("(%v) when expecting ([1 2])", check)
+			}
+		})
+		t.Run("register the loaded supplier polling interval", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			expected := fmt.Errorf("error message")
+			supplierEntry := ConfigPartial{"type": "my type", "interval": 60000}
+			suppliers := ConfigPartial{}
+			_, _ = suppliers.Set("slate.config.suppliers", ConfigPartial{"supplier": supplierEntry})
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Close().Times(1)
+			supplier1.EXPECT().Get("").Return(suppliers, nil).AnyTimes()
+			supplier2 := NewMockConfigObsSupplier(ctrl)
+			supplier2.EXPECT().Close().Times(1)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{}, nil).AnyTimes()
 			supplierCreator := NewMockConfigSupplierCreator(ctrl)
-			supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true).Times(1)
-			supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(nil, expected).Times(1)
+			gomock.InOrder(
+				supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true),
+				supplierCreator.EXPECT().Accept(&supplierEntry).Return(true),
+			)
+			gomock.InOrder(
+				supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier1, nil),
+				supplierCreator.EXPECT().Create(&supplierEntry).Return(supplier2, nil),
+			)
 			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
+			config := NewConfig()
+			defer func() { _ = config.Close() }()
 
-			sut, _ := NewConfigLoader(NewConfig(), supplierFactory)
+			sut, _ := NewConfigLoader(config, supplierFactory)
 
-			if e := sut.Load(); e == nil {
-				t.Error("didn't returned the expected error")
-			} else if e.Error() != expected.Error() {
-				t.Errorf("(%v) when expecting (%v)", e, expected)
+			if e := sut.Load(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if ref := config.suppliers[1]; ref.interval != time.Minute {
+				t.Errorf("stored the (%v) interval", ref.interval)
+			} else if ref.trigger == nil {
+				t.Error("didn't created the supplier polling trigger")
 			}
 		})
 
-		t.Run("error storing the base supplier", func(t *testing.T) {
+		t.Run("error parsing the entry suppliers list", func(t *testing.T) {
+			for _, entries := range []string{"{", "[1]"} {
+				ConfigLoaderEntries = entries
+
+				sut, _ := NewConfigLoader(NewConfig(), NewConfigSupplierFactory(nil))
+
+				if e := sut.Load(); e == nil {
+					t.Errorf("didn't returned the expected error for (%s)", entries)
+				}
+			}
+			ConfigLoaderEntries = ""
+		})
+
+		t.Run("load the entry suppliers list in order", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
+			ConfigLoaderEntries = `[{"type": "env", "mappings": {"field": "ENV_FIELD"}}, {"type": "rest", "uri": "uri"}]`
+			defer func() { ConfigLoaderEntries = "" }()
+
+			entry1 := ConfigPartial{"type": "env", "mappings": ConfigPartial{"field": "ENV_FIELD"}}
+			entry2 := ConfigPartial{"type": "rest", "uri": "uri"}
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(ConfigPartial{"field": "value 1", "other": "value 1"}, nil).AnyTimes()
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"field": "value 2"}, nil).AnyTimes()
 			supplierCreator := NewMockConfigSupplierCreator(ctrl)
-			supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true).Times(1)
-			supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier, nil).Times(1)
+			supplierCreator.EXPECT().Accept(&entry1).Return(true).Times(1)
+			supplierCreator.EXPECT().Accept(&entry2).Return(true).Times(1)
+			supplierCreator.EXPECT().Create(&entry1).Return(supplier1, nil).Times(1)
+			supplierCreator.EXPECT().Create(&entry2).Return(supplier2, nil).Times(1)
 			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
 			config := NewConfig()
-			_ = config.AddSupplier(ConfigLoaderSupplierID, 0, supplier)
 
 			sut, _ := NewConfigLoader(config, supplierFactory)
 
-			if e := sut.Load(); e == nil {
-				t.Error("didn't returned the expected error")
-			} else if !errors.Is(e, ErrDuplicateConfigSupplier) {
-				t.Errorf("(%v) when expecting (%v)", e, ErrDuplicateConfigSupplier)
+			if e := sut.Load(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if !config.HasSupplier(ConfigLoaderSupplierID) || !config.HasSupplier(ConfigLoaderSupplierID+".1") {
+				t.Error("didn't registered the entry suppliers")
+			} else if check, _ := config.String("field"); check != "value 2" {
+				t.Errorf("(%v) when expecting (value 2)", check)
+			} else if check, _ := config.String("other"); check != "value 1" {
+				t.Errorf("(%v) when expecting (value 1)", check)
+			}
+		})
+
+		t.Run("skip an unavailable optional supplier", func(t *testing.T) {
+			for _, supplierEntry := range []ConfigPartial{
+				{"type": "my type", "optional": true},
+				{"type": "my type", "required": false},
+			} {
+				ctrl := gomock.NewController(t)
+
+				suppliers := ConfigPartial{}
+				_, _ = suppliers.Set("slate.config.suppliers", ConfigPartial{"supplier": supplierEntry})
+				supplier := NewMockConfigSupplier(ctrl)
+				supplier.EXPECT().Get("").Return(suppliers, nil).AnyTimes()
+				supplierCreator := NewMockConfigSupplierCreator(ctrl)
+				supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true).Times(1)
+				supplierCreator.EXPECT().Accept(&supplierEntry).Return(true).Times(1)
+				supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier, nil).Times(1)
+				supplierCreator.EXPECT().Create(&supplierEntry).Return(nil, fmt.Errorf("error message")).Times(1)
+				supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
+				config := NewConfig()
+
+				sut, _ := NewConfigLoader(config, supplierFactory)
+
+				if e := sut.Load(); e != nil {
+					t.Errorf("unexpected (%v) error", e)
+				} else if config.HasSupplier("supplier") {
+					t.Error("registered the unavailable supplier")
+				} else if warnings := config.Warnings(); len(warnings) != 1 {
+					t.Errorf("recorded (%d) warnings", len(warnings))
+				} else if !errors.Is(warnings[0], ErrConfigSupplierUnavailable) {
+					t.Errorf("(%v) when expecting (%v)", warnings[0], ErrConfigSupplierUnavailable)
+				}
+
+				ctrl.Finish()
 			}
 		})
 
-		t.Run("add base supplier into the partial", func(t *testing.T) {
+		t.Run("use the fallback supplier of an unavailable supplier", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Get("").Return(ConfigPartial{}, nil).Times(1)
+			fallbackEntry := ConfigPartial{"type": "my fallback type"}
+			supplierEntry := ConfigPartial{"type": "my type", "fallback": fallbackEntry}
+			suppliers := ConfigPartial{}
+			_, _ = suppliers.Set("slate.config.suppliers", ConfigPartial{"supplier": supplierEntry})
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(suppliers, nil).AnyTimes()
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"field": "fallback"}, nil).AnyTimes()
 			supplierCreator := NewMockConfigSupplierCreator(ctrl)
 			supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true).Times(1)
-			supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier, nil).Times(1)
+			supplierCreator.EXPECT().Accept(&supplierEntry).Return(true).Times(1)
+			supplierCreator.EXPECT().Accept(&fallbackEntry).Return(true).Times(1)
+			supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier1, nil).Times(1)
+			supplierCreator.EXPECT().Create(&supplierEntry).Return(nil, fmt.Errorf("error message")).Times(1)
+			supplierCreator.EXPECT().Create(&fallbackEntry).Return(supplier2, nil).Times(1)
 			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
 			config := NewConfig()
 
@@ -7265,137 +12192,192 @@ func Test_ConfigLoader(t *testing.T) {
 
 			if e := sut.Load(); e != nil {
 				t.Errorf("unexpected (%v) error", e)
-			} else if len(config.suppliers) == 0 {
-				t.Error("didn't stored the base supplier")
+			} else if check, _ := config.String("field"); check != "fallback" {
+				t.Errorf("(%v) when expecting (fallback)", check)
+			} else if warnings := config.Warnings(); len(warnings) != 1 {
+				t.Errorf("recorded (%d) warnings", len(warnings))
 			}
 		})
 
-		t.Run("invalid list of suppliers results in an empty suppliers list", func(t *testing.T) {
+		t.Run("use the fallback supplier entry of an unavailable supplier", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			partial := ConfigPartial{}
-			_, _ = partial.Set("slate.config.suppliers", "string")
-			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Get("").Return(partial, nil).Times(1)
+			fallbackEntry := ConfigPartial{"type": "my fallback type", "optional": true}
+			supplierEntry := ConfigPartial{"type": "my type", "fallback": "other"}
+			suppliers := ConfigPartial{}
+			_, _ = suppliers.Set("slate.config.suppliers", ConfigPartial{"supplier": supplierEntry, "other": fallbackEntry})
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(suppliers, nil).AnyTimes()
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"field": "fallback"}, nil).AnyTimes()
 			supplierCreator := NewMockConfigSupplierCreator(ctrl)
 			supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true).Times(1)
-			supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier, nil).Times(1)
+			supplierCreator.EXPECT().Accept(&supplierEntry).Return(true).Times(1)
+			supplierCreator.EXPECT().Accept(&fallbackEntry).Return(true).Times(2)
+			supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier1, nil).Times(1)
+			supplierCreator.EXPECT().Create(&supplierEntry).Return(nil, fmt.Errorf("error message")).Times(1)
+			supplierCreator.EXPECT().Create(&fallbackEntry).Return(supplier2, nil).Times(2)
 			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
+			config := NewConfig()
 
-			sut, _ := NewConfigLoader(NewConfig(), supplierFactory)
+			sut, _ := NewConfigLoader(config, supplierFactory)
 
 			if e := sut.Load(); e != nil {
 				t.Errorf("unexpected (%v) error", e)
+			} else if !config.HasSupplier("supplier") {
+				t.Error("didn't registered the fallback supplier")
 			}
 		})
 
-		t.Run("error parsing supplier entry", func(t *testing.T) {
+		t.Run("error on unavailable required supplier without available fallback", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			entry := ConfigPartial{"priority": "string"}
-			partial := ConfigPartial{}
-			_, _ = partial.Set("slate.config.suppliers", ConfigPartial{"supplier": entry})
+			expected := fmt.Errorf("error message")
+			fallbackEntry := ConfigPartial{"type": "my fallback type"}
+			supplierEntry := ConfigPartial{"type": "my type", "fallback": fallbackEntry}
+			suppliers := ConfigPartial{}
+			_, _ = suppliers.Set("slate.config.suppliers", ConfigPartial{"supplier": supplierEntry})
 			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Get("").Return(partial, nil).Times(1)
+			supplier.EXPECT().Get("").Return(suppliers, nil).AnyTimes()
 			supplierCreator := NewMockConfigSupplierCreator(ctrl)
 			supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true).Times(1)
+			supplierCreator.EXPECT().Accept(&supplierEntry).Return(true).Times(1)
+			supplierCreator.EXPECT().Accept(&fallbackEntry).Return(true).Times(1)
 			supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier, nil).Times(1)
+			supplierCreator.EXPECT().Create(&supplierEntry).Return(nil, expected).Times(1)
+			supplierCreator.EXPECT().Create(&fallbackEntry).Return(nil, fmt.Errorf("fallback error")).Times(1)
 			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
 
 			sut, _ := NewConfigLoader(NewConfig(), supplierFactory)
 
 			if e := sut.Load(); e == nil {
 				t.Error("didn't returned the expected error")
-			} else if !errors.Is(e, ErrConversion) {
-				t.Errorf("(%v) when expecting (%v)", e, ErrConversion)
+			} else if !errors.Is(e, expected) {
+				t.Errorf("(%v) when expecting (%v)", e, expected)
 			}
 		})
 
-		t.Run("error creating the supplier entry", func(t *testing.T) {
+		t.Run("store and use the supplier cache copy", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			expected := fmt.Errorf("error message")
-			entry := ConfigPartial{"type": "my type"}
-			partial := ConfigPartial{}
-			_, _ = partial.Set("slate.config.suppliers", ConfigPartial{"supplier": entry})
-			supplier := NewMockConfigSupplier(ctrl)
-			supplier.EXPECT().Get("").Return(partial, nil).Times(1)
+			fileSystem := afero.NewMemMapFs()
+			supplierEntry := ConfigPartial{"type": "my type", "cache_path": "cache/supplier.json"}
+			cacheEntry := ConfigPartial{"type": ConfigTypeFile, "path": "cache/supplier.json", "format": ConfigFormatJSON}
+			suppliers := ConfigPartial{}
+			_, _ = suppliers.Set("slate.config.suppliers", ConfigPartial{"supplier": supplierEntry})
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(suppliers, nil).AnyTimes()
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"field": "value"}, nil).AnyTimes()
 			supplierCreator := NewMockConfigSupplierCreator(ctrl)
+			supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true).Times(2)
+			supplierCreator.EXPECT().Accept(&supplierEntry).Return(true).Times(2)
+			supplierCreator.EXPECT().Accept(&cacheEntry).Return(false).Times(1)
 			gomock.InOrder(
-				supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true),
-				supplierCreator.EXPECT().Accept(&entry).Return(true),
-			)
-			gomock.InOrder(
-				supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier, nil),
-				supplierCreator.EXPECT().Create(&entry).Return(nil, expected),
+				supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier1, nil),
+				supplierCreator.EXPECT().Create(&supplierEntry).Return(supplier2, nil),
+				supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier1, nil),
+				supplierCreator.EXPECT().Create(&supplierEntry).Return(nil, fmt.Errorf("error message")),
 			)
+			fileCreator, _ := NewConfigFileSourceCreator(fileSystem, NewConfigParserFactory([]ConfigParserCreator{NewConfigJSONDecoderCreator()}))
+			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator, fileCreator})
+
+			// first load with the supplier available
+			sut, _ := NewConfigLoader(NewConfig(), supplierFactory, fileSystem)
+			if e := sut.Load(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if check, _ := afero.ReadFile(fileSystem, "cache/supplier.json"); !strings.Contains(string(check), `"field": "value"`) {
+				t.Errorf("stored the (%s) cache copy", check)
+			}
+
+			// second load with the supplier unavailable
+			config := NewConfig()
+			sut, _ = NewConfigLoader(config, supplierFactory, fileSystem)
+			if e := sut.Load(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if check, _ := config.String("field"); check != "value" {
+				t.Errorf("(%v) when expecting (value)", check)
+			} else if warnings := config.Warnings(); len(warnings) != 1 {
+				t.Errorf("recorded (%d) warnings", len(warnings))
+			}
+		})
+
+		t.Run("error on supplier cache copy without file system", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			supplierEntry := ConfigPartial{"type": "my type", "cache_path": "cache/supplier.json"}
+			suppliers := ConfigPartial{}
+			_, _ = suppliers.Set("slate.config.suppliers", ConfigPartial{"supplier": supplierEntry})
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(suppliers, nil).AnyTimes()
+			supplierCreator := NewMockConfigSupplierCreator(ctrl)
+			supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true).Times(1)
+			supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier, nil).Times(1)
 			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
 
 			sut, _ := NewConfigLoader(NewConfig(), supplierFactory)
 
 			if e := sut.Load(); e == nil {
 				t.Error("didn't returned the expected error")
-			} else if e.Error() != expected.Error() {
-				t.Errorf("(%v) when expecting (%v)", e, expected)
+			} else if !errors.Is(e, ErrNilPointer) {
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
 			}
 		})
 
-		t.Run("error on supplier registration", func(t *testing.T) {
+		t.Run("apply the supplier case policy", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			supplierEntry := ConfigPartial{"type": "my type"}
+			prev := ConfigCase
+			ConfigCase = ConfigCasePreserve
+			defer func() { ConfigCase = prev }()
+
+			supplierEntry := ConfigPartial{"type": "my type", "case": ConfigCaseLower}
 			suppliers := ConfigPartial{}
 			_, _ = suppliers.Set("slate.config.suppliers", ConfigPartial{"supplier": supplierEntry})
 			supplier1 := NewMockConfigSupplier(ctrl)
-			supplier1.EXPECT().Get("").Return(suppliers, nil).Times(1)
+			supplier1.EXPECT().Get("").Return(suppliers, nil).AnyTimes()
 			supplier2 := NewMockConfigSupplier(ctrl)
-			supplier2.EXPECT().Get("").Return(supplierEntry, nil).Times(2)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"Field": "value"}, nil).Times(1)
 			supplierCreator := NewMockConfigSupplierCreator(ctrl)
-			gomock.InOrder(
-				supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true),
-				supplierCreator.EXPECT().Accept(&supplierEntry).Return(true),
-			)
-			gomock.InOrder(
-				supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier1, nil),
-				supplierCreator.EXPECT().Create(&supplierEntry).Return(supplier2, nil),
-			)
+			supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true).Times(1)
+			supplierCreator.EXPECT().Accept(&supplierEntry).Return(true).Times(1)
+			supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier1, nil).Times(1)
+			supplierCreator.EXPECT().Create(&supplierEntry).Return(supplier2, nil).Times(1)
 			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
 			config := NewConfig()
-			_ = config.AddSupplier("supplier", 0, supplier2)
 
 			sut, _ := NewConfigLoader(config, supplierFactory)
 
-			if e := sut.Load(); e == nil {
-				t.Error("didn't returned the expected error")
-			} else if !errors.Is(e, ErrDuplicateConfigSupplier) {
-				t.Errorf("(%v) when expecting (%v)", e, ErrDuplicateConfigSupplier)
+			if e := sut.Load(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+			} else if check, _ := config.String("field"); check != "value" {
+				t.Errorf("(%v) when expecting (value)", check)
 			}
 		})
 
-		t.Run("register the loaded supplier", func(t *testing.T) {
+		t.Run("preserve the parsed supplier keys case", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			supplierEntry := ConfigPartial{"type": "my type"}
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "config.yaml", []byte("Headers: {Content-Type: json}"), 0o644)
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigYAMLDecoderCreator()})
+			supplierEntry := ConfigPartial{"type": "my type", "case": ConfigCasePreserve}
 			suppliers := ConfigPartial{}
 			_, _ = suppliers.Set("slate.config.suppliers", ConfigPartial{"supplier": supplierEntry})
 			supplier1 := NewMockConfigSupplier(ctrl)
-			supplier1.EXPECT().Get("").Return(suppliers, nil).Times(2)
-			supplier2 := NewMockConfigSupplier(ctrl)
-			supplier2.EXPECT().Get("").Return(supplierEntry, nil).Times(1)
+			supplier1.EXPECT().Get("").Return(suppliers, nil).AnyTimes()
+			supplier2, _ := NewConfigFileSource("config.yaml", ConfigFormatYAML, fileSystem, parserFactory)
 			supplierCreator := NewMockConfigSupplierCreator(ctrl)
-			gomock.InOrder(
-				supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true),
-				supplierCreator.EXPECT().Accept(&supplierEntry).Return(true),
-			)
-			gomock.InOrder(
-				supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier1, nil),
-				supplierCreator.EXPECT().Create(&supplierEntry).Return(supplier2, nil),
-			)
+			supplierCreator.EXPECT().Accept(&baseSupplierPartial).Return(true).Times(1)
+			supplierCreator.EXPECT().Accept(&supplierEntry).Return(true).Times(1)
+			supplierCreator.EXPECT().Create(&baseSupplierPartial).Return(supplier1, nil).Times(1)
+			supplierCreator.EXPECT().Create(&supplierEntry).Return(supplier2, nil).Times(1)
 			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{supplierCreator})
 			config := NewConfig()
 
@@ -7403,6 +12385,173 @@ func Test_ConfigLoader(t *testing.T) {
 
 			if e := sut.Load(); e != nil {
 				t.Errorf("unexpected (%v) error", e)
+			} else if check, _ := config.String("Headers.Content-Type"); check != "json" {
+				t.Errorf("(%v) when expecting (json)", check)
+			}
+		})
+
+		t.Run("load the active profiles entry files and overlays", func(t *testing.T) {
+			prev := ConfigProfiles
+			ConfigProfiles = []string{"prod", "eu"}
+			defer func() { ConfigProfiles = prev }()
+			prevFormat := ConfigLoaderSupplierFormat
+			ConfigLoaderSupplierFormat = ConfigFormatYAML
+			defer func() { ConfigLoaderSupplierFormat = prevFormat }()
+
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, ConfigLoaderFileSupplierPath, []byte(`
+slate:
+  config:
+    suppliers:
+      app:
+        type: file
+        path: app.yaml
+      dev:
+        type: file
+        path: dev.yaml
+        profiles: [dev]
+`), 0o644)
+			_ = afero.WriteFile(fileSystem, configProfilePath(ConfigLoaderFileSupplierPath, "prod"), []byte(`
+slate:
+  config:
+    suppliers:
+      prod:
+        type: file
+        path: prod.yaml
+        priority: 10
+        profiles: [prod]
+`), 0o644)
+			_ = afero.WriteFile(fileSystem, "app.yaml", []byte("field1: app\nfield2: app\nfield3: app\n"), 0o644)
+			_ = afero.WriteFile(fileSystem, "app.prod.yaml", []byte("field1: app.prod\nfield2: app.prod\n"), 0o644)
+			_ = afero.WriteFile(fileSystem, "prod.yaml", []byte("field1: prod\n"), 0o644)
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigYAMLDecoderCreator()})
+			fileCreator, _ := NewConfigFileSourceCreator(fileSystem, parserFactory)
+			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{fileCreator})
+			config := NewConfig()
+
+			sut, _ := NewConfigLoader(config, supplierFactory)
+
+			expected := ConfigPartial{"field1": "prod", "field2": "app.prod", "field3": "app"}
+			if e := sut.Load(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+				return
+			}
+			check := ConfigPartial{}
+			for _, field := range []string{"field1", "field2", "field3"} {
+				check[field], _ = config.String(field)
+			}
+			switch {
+			case !reflect.DeepEqual(check, expected):
+				t.Errorf("(%v) when expecting (%v)", check, expected)
+			case config.HasSupplier("dev"):
+				t.Error("loaded a supplier of an inactive profile")
+			case config.HasSupplier("app.eu"):
+				t.Error("loaded a non-existent profile overlay")
+			case !config.HasSupplier(ConfigLoaderSupplierID + ".prod"):
+				t.Error("didn't loaded the profile entry file")
+			}
+		})
+
+		t.Run("merge the entries profile overlays in the entries order", func(t *testing.T) {
+			prev := ConfigProfiles
+			ConfigProfiles = []string{"prod"}
+			defer func() { ConfigProfiles = prev }()
+			ConfigLoaderEntries = `[{"type": "file", "path": "base.yaml", "format": "yaml"}, {"type": "file", "path": "extra.yaml", "format": "yaml"}]`
+			defer func() { ConfigLoaderEntries = "" }()
+
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, "base.yaml", []byte("field1: base\nfield2: base\n"), 0o644)
+			_ = afero.WriteFile(fileSystem, "base.prod.yaml", []byte("field1: base.prod\nfield2: base.prod\n"), 0o644)
+			_ = afero.WriteFile(fileSystem, "extra.yaml", []byte("field2: extra\n"), 0o644)
+			_ = afero.WriteFile(fileSystem, "extra.prod.yaml", []byte("field3: extra.prod\n"), 0o644)
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigYAMLDecoderCreator()})
+			fileCreator, _ := NewConfigFileSourceCreator(fileSystem, parserFactory)
+			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{fileCreator})
+			config := NewConfig()
+
+			sut, _ := NewConfigLoader(config, supplierFactory)
+
+			expected := ConfigPartial{"field1": "base.prod", "field2": "extra", "field3": "extra.prod"}
+			expectedPriorities := map[string]int{
+				ConfigLoaderSupplierID:             0,
+				ConfigLoaderSupplierID + ".prod":   1,
+				ConfigLoaderSupplierID + ".1":      2,
+				ConfigLoaderSupplierID + ".1.prod": 3,
+			}
+			if e := sut.Load(); e != nil {
+				t.Errorf("unexpected (%v) error", e)
+				return
+			}
+			check := ConfigPartial{}
+			for _, field := range []string{"field1", "field2", "field3"} {
+				check[field], _ = config.String(field)
+			}
+			priorities := map[string]int{}
+			for _, ref := range config.suppliers {
+				priorities[ref.id] = ref.priority
+			}
+			switch {
+			case !reflect.DeepEqual(check, expected):
+				t.Errorf("(%v) when expecting (%v)", check, expected)
+			case !reflect.DeepEqual(priorities, expectedPriorities):
+				t.Errorf("registered the entries with the (%v) priorities", priorities)
+			}
+		})
+
+		t.Run("profile overlays override same priority suppliers", func(t *testing.T) {
+			prev := ConfigProfiles
+			ConfigProfiles = []string{"prod", "eu"}
+			defer func() { ConfigProfiles = prev }()
+			prevFormat := ConfigLoaderSupplierFormat
+			ConfigLoaderSupplierFormat = ConfigFormatYAML
+			defer func() { ConfigLoaderSupplierFormat = prevFormat }()
+
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, ConfigLoaderFileSupplierPath, []byte(`
+slate:
+  config:
+    suppliers:
+      b:
+        type: file
+        path: b.yaml
+        priority: 5
+      a:
+        type: file
+        path: a.yaml
+        priority: 5
+`), 0o644)
+			_ = afero.WriteFile(fileSystem, "a.yaml", []byte("field1: a\nfield2: a\nfield3: a\n"), 0o644)
+			_ = afero.WriteFile(fileSystem, "a.prod.yaml", []byte("field1: a.prod\nfield2: a.prod\n"), 0o644)
+			_ = afero.WriteFile(fileSystem, "a.eu.yaml", []byte("field1: a.eu\n"), 0o644)
+			_ = afero.WriteFile(fileSystem, "b.yaml", []byte("field2: b\nfield3: b\n"), 0o644)
+			parserFactory := NewConfigParserFactory([]ConfigParserCreator{NewConfigYAMLDecoderCreator()})
+			fileCreator, _ := NewConfigFileSourceCreator(fileSystem, parserFactory)
+			supplierFactory := NewConfigSupplierFactory([]ConfigSupplierCreator{fileCreator})
+
+			expected := ConfigPartial{"field1": "a.eu", "field2": "a.prod", "field3": "b"}
+			for i := 0; i < 10; i++ {
+				config := NewConfig()
+				sut, _ := NewConfigLoader(config, supplierFactory)
+				if e := sut.Load(); e != nil {
+					t.Errorf("unexpected (%v) error", e)
+					return
+				}
+				check := ConfigPartial{}
+				for _, field := range []string{"field1", "field2", "field3"} {
+					check[field], _ = config.String(field)
+				}
+				priorities := map[string]int{}
+				for _, ref := range config.suppliers {
+					priorities[ref.id] = ref.priority
+				}
+				switch {
+				case !reflect.DeepEqual(check, expected):
+					t.Errorf("(%v) when expecting (%v)", check, expected)
+					return
+				case priorities["a.prod"] != 6 || priorities["a.eu"] != 7:
+					t.Errorf("registered the overlays with the (%v) priorities", priorities)
+					return
+				}
 			}
 		})
 
@@ -7580,10 +12729,16 @@ func Test_ConfigServiceRegister(t *testing.T) {
 				t.Errorf("no observable file source creator : %v", sut)
 			case !container.Has(ConfigDirSourceCreatorContainerID):
 				t.Errorf("no dir source creator : %v", sut)
+			case !container.Has(ConfigEmbeddedFileSourceCreatorContainerID):
+				t.Errorf("no embedded file source creator : %v", sut)
+			case !container.Has(ConfigEmbeddedDirSourceCreatorContainerID):
+				t.Errorf("no embedded dir source creator : %v", sut)
 			case !container.Has(ConfigRestSourceCreatorContainerID):
 				t.Errorf("no rest source creator : %v", sut)
 			case !container.Has(ConfigObsRestSourceCreatorContainerID):
 				t.Errorf("no observable rest source creator : %v", sut)
+			case !container.Has(ConfigCommandSourceCreatorContainerID):
+				t.Errorf("no command source creator : %v", sut)
 			case !container.Has(ConfigAllSupplierCreatorsContainerID):
 				t.Errorf("no supplier creators aggregator : %v", sut)
 			case !container.Has(ConfigSupplierFactoryContainerID):
@@ -7786,6 +12941,44 @@ func Test_ConfigServiceRegister(t *testing.T) {
 			}
 		})
 
+		t.Run("retrieving embedded file source creator", func(t *testing.T) {
+			container := NewServiceContainer()
+			_ = NewConfigServiceRegister(nil).Provide(container)
+
+			factory, e := container.Get(ConfigEmbeddedFileSourceCreatorContainerID)
+			switch {
+			case e != nil:
+				t.Errorf("unexpected error (%v)", e)
+			case factory == nil:
+				t.Error("didn't returned a valid reference")
+			default:
+				switch factory.(type) {
+				case *ConfigEmbeddedFileSourceCreator:
+				default:
+					t.Error("didn't return an embedded file source creator reference")
+				}
+			}
+		})
+
+		t.Run("retrieving embedded dir source creator", func(t *testing.T) {
+			container := NewServiceContainer()
+			_ = NewConfigServiceRegister(nil).Provide(container)
+
+			factory, e := container.Get(ConfigEmbeddedDirSourceCreatorContainerID)
+			switch {
+			case e != nil:
+				t.Errorf("unexpected error (%v)", e)
+			case factory == nil:
+				t.Error("didn't returned a valid reference")
+			default:
+				switch factory.(type) {
+				case *ConfigEmbeddedDirSourceCreator:
+				default:
+					t.Error("didn't return an embedded dir source creator reference")
+				}
+			}
+		})
+
 		t.Run("retrieving rest source creator", func(t *testing.T) {
 			container := NewServiceContainer()
 			_ = NewConfigServiceRegister(nil).Provide(container)
@@ -7824,6 +13017,25 @@ func Test_ConfigServiceRegister(t *testing.T) {
 			}
 		})
 
+		t.Run("retrieving command source creator", func(t *testing.T) {
+			container := NewServiceContainer()
+			_ = NewConfigServiceRegister(nil).Provide(container)
+
+			factory, e := container.Get(ConfigCommandSourceCreatorContainerID)
+			switch {
+			case e != nil:
+				t.Errorf("unexpected error (%v)", e)
+			case factory == nil:
+				t.Error("didn't returned a valid reference")
+			default:
+				switch factory.(type) {
+				case *ConfigCommandSourceCreator:
+				default:
+					t.Error("didn't return a command source creator reference")
+				}
+			}
+		})
+
 		t.Run("retrieving aggregate suppliers", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
@@ -8094,5 +13306,24 @@ func Test_ConfigServiceRegister(t *testing.T) {
 				t.Errorf("unexpected error (%v)", e)
 			}
 		})
+
+		t.Run("request a custom loader to init config", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			loader := NewMockConfigLoaderInterface(ctrl)
+			loader.EXPECT().Load().Return(nil).Times(1)
+			container := NewServiceContainer()
+			_ = NewFileSystemServiceRegister(nil).Provide(container)
+			sut := NewConfigServiceRegister(nil)
+			_ = sut.Provide(container)
+			_ = container.Add(ConfigLoaderContainerID, func() ConfigLoaderInterface {
+				return loader
+			})
+
+			if e := sut.Boot(container); e != nil {
+				t.Errorf("unexpected error (%v)", e)
+			}
+		})
 	})
 }
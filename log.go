@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"reflect"
 	"sort"
 	"strings"
 	"sync"
@@ -119,6 +120,15 @@ var (
 	// used to register in the config object an observer of the logger
 	// config entries list, so it can reload the Log writers.
 	LogLoaderObserveConfig = EnvBool(LogEnvID+"_LOADER_OBSERVE_CONFIG", true)
+
+	// LogLoaderConfigErrorChannel defines the logging channel used by the
+	// loader to report the config manager background reload failures.
+	LogLoaderConfigErrorChannel = EnvString(LogEnvID+"_LOADER_CONFIG_ERROR_CHANNEL", "config")
+
+	// LogLoaderConfigChangeChannel defines the logging channel used by the
+	// loader to report the committed config revisions. Empty for no
+	// config change logging.
+	LogLoaderConfigChangeChannel = EnvString(LogEnvID+"_LOADER_CONFIG_CHANGE_CHANNEL", "")
 )
 
 // ----------------------------------------------------------------------------
@@ -1310,20 +1320,83 @@ func (l LogLoader) Load() error {
 	if e := l.load(entries); e != nil {
 		return e
 	}
+	// report the config background reload failures through the logger
+	_ = l.config.AddErrorHandler(func(e error) {
+		_ = l.log.Signal(LogLoaderConfigErrorChannel, ERROR, "config reload failed", LogContext{"error": e.Error()})
+	})
+	// report the config warnings (like skipped unavailable suppliers)
+	// through the logger
+	_ = l.config.AddWarningHandler(func(e error) {
+		_ = l.log.Signal(LogLoaderConfigErrorChannel, WARNING, "config warning", LogContext{"error": e.Error()})
+	})
+	// report the committed config revisions through the logger
+	// if a config change channel is defined
+	if LogLoaderConfigChangeChannel != "" {
+		_ = l.config.AddRevisionHandler(func(revision ConfigRevision) {
+			_ = l.log.Signal(LogLoaderConfigChangeChannel, INFO, "config changed", LogContext{
+				"revision": revision.Revision,
+				"supplier": revision.Supplier,
+				"changes":  revision.Changes,
+			})
+		})
+	}
 	// check if the logger writers list should be observed for updates
 	if LogLoaderObserveConfig {
+		// add a prepare hook to the given config that will create the
+		// writers of a changed logger config before it's committed, so a
+		// failing writer config rejects the config change, and discard
+		// them if the change is rejected by a later prepare hook
+		var mutex sync.Mutex
+		var prepared map[string]LogWriter
+		take := func() map[string]LogWriter {
+			mutex.Lock()
+			defer mutex.Unlock()
+			writers := prepared
+			prepared = nil
+			return writers
+		}
+		_ = l.config.AddPrepareHook(func(old, new ConfigPartial) error {
+			// discard any previously prepared writers that were not used
+			l.discard(take())
+			// check if the logger config has changed
+			current, _ := old.Get(LogLoaderConfigPath, nil)
+			candidate, _ := new.Get(LogLoaderConfigPath, nil)
+			if reflect.DeepEqual(current, candidate) {
+				return nil
+			}
+			// create the writers of the candidate logger config
+			entries, e := new.Partial(LogLoaderConfigPath, ConfigPartial{})
+			if e != nil {
+				return e
+			}
+			writers, e := l.create(entries)
+			if e != nil {
+				return e
+			}
+			mutex.Lock()
+			prepared = writers
+			mutex.Unlock()
+			return nil
+		}, func(_, _ ConfigPartial) {
+			l.discard(take())
+		})
 		// add the observer to the given config
-		_ = l.config.AddObserver(
+		_, _ = ObserveAs(
+			l.config,
 			LogLoaderConfigPath,
-			func(_ interface{}, newConfig interface{}) {
-				// type check the new logger config with the logging streams
-				config, ok := newConfig.(ConfigPartial)
-				if !ok {
+			func(_ ConfigPartial, config ConfigPartial) {
+				// ignore the removal of the logger config
+				if config == nil {
 					return
 				}
 				// remove all the current registered writers
 				l.log.RemoveAllWriters()
-				// load the new writer entries into the logging manager
+				// add the prepared writers, if any, or load the new
+				// writer entries into the logging manager
+				if writers := take(); writers != nil {
+					_ = l.add(writers)
+					return
+				}
 				_ = l.load(config)
 			},
 		)
@@ -1334,19 +1407,43 @@ func (l LogLoader) Load() error {
 func (l LogLoader) load(
 	config ConfigPartial,
 ) error {
+	// create the writers of the given logger config
+	writers, e := l.create(config)
+	if e != nil {
+		return e
+	}
+	// add the writers to the logger writer pool
+	return l.add(writers)
+}
+
+func (l LogLoader) create(
+	config ConfigPartial,
+) (map[string]LogWriter, error) {
 	// iterate through the given logger config writer list
+	writers := map[string]LogWriter{}
 	for _, id := range config.Entries() {
 		// get the configuration
 		entry, e := config.Partial(id)
 		if e != nil {
-			return e
+			l.discard(writers)
+			return nil, e
 		}
 		// generate the new writer instance
 		writer, e := l.writerFactory.Create(&entry)
 		if e != nil {
-			return e
+			l.discard(writers)
+			return nil, e
 		}
-		// add the writer to the logger writer pool
+		writers[id] = writer
+	}
+	return writers, nil
+}
+
+func (l LogLoader) add(
+	writers map[string]LogWriter,
+) error {
+	// add the writers to the logger writer pool
+	for id, writer := range writers {
 		if e := l.log.AddWriter(id, writer); e != nil {
 			return e
 		}
@@ -1354,6 +1451,17 @@ func (l LogLoader) load(
 	return nil
 }
 
+func (LogLoader) discard(
+	writers map[string]LogWriter,
+) {
+	// close all the given writers that implement the closer interface
+	for _, writer := range writers {
+		if closer, ok := writer.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}
+}
+
 // ----------------------------------------------------------------------------
 // log service register
 // ----------------------------------------------------------------------------
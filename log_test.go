@@ -3768,6 +3768,217 @@ func Test_LogLoader(t *testing.T) {
 
 			_ = config.AddSupplier("supplier.2", 100, supplier2)
 		})
+
+		t.Run("reject a config change with an invalid writer config", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := fmt.Errorf("error message")
+			config1 := ConfigPartial{
+				"type":     "console",
+				"format":   "json",
+				"Channels": []interface{}{},
+				"Level":    "fatal",
+			}
+			config2 := ConfigPartial{
+				"type":     "console",
+				"format":   "json",
+				"Channels": []interface{}{},
+				"Level":    "invalid",
+			}
+			partial1 := ConfigPartial{}
+			_, _ = partial1.Set("slate.log.writers.id", config1)
+			partial2 := ConfigPartial{}
+			_, _ = partial2.Set("slate.log.writers.id", config2)
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(partial1, nil).Times(2)
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(partial2, nil).Times(1)
+			config := NewConfig()
+			_ = config.AddSupplier("supplier.1", 1, supplier1)
+			writer := NewMockLogWriter(ctrl)
+			writerCreator := NewMockLogWriterCreator(ctrl)
+			gomock.InOrder(
+				writerCreator.EXPECT().Accept(&config1).Return(true),
+				writerCreator.EXPECT().Accept(&config2).Return(true),
+			)
+			gomock.InOrder(
+				writerCreator.EXPECT().Create(&config1).Return(writer, nil),
+				writerCreator.EXPECT().Create(&config2).Return(nil, expected),
+			)
+			writerFactory := NewLogWriterFactory([]LogWriterCreator{writerCreator})
+			log := NewLog()
+
+			sut, _ := NewLogLoader(config, log, writerFactory)
+			_ = sut.Load()
+
+			if e := config.AddSupplier("supplier.2", 100, supplier2); e == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(e, expected) {
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			} else if check, _ := log.Writer("id"); check != writer {
+				t.Error("didn't kept the current writer")
+			}
+		})
+
+		t.Run("discard the prepared writers of a rejected config change", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := fmt.Errorf("error message")
+			config1 := ConfigPartial{
+				"type":     "console",
+				"format":   "json",
+				"Channels": []interface{}{},
+				"Level":    "fatal",
+			}
+			config2 := ConfigPartial{
+				"type":     "console",
+				"format":   "json",
+				"Channels": []interface{}{},
+				"Level":    "debug",
+			}
+			partial1 := ConfigPartial{}
+			_, _ = partial1.Set("slate.log.writers.id", config1)
+			partial2 := ConfigPartial{}
+			_, _ = partial2.Set("slate.log.writers.id", config2)
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(partial1, nil).Times(2)
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(partial2, nil).Times(1)
+			config := NewConfig()
+			_ = config.AddSupplier("supplier.1", 1, supplier1)
+			writer1 := NewMockLogWriter(ctrl)
+			writer2 := NewMockLogWriter(ctrl)
+			writer2.EXPECT().Close().Return(nil).Times(1)
+			writerCreator := NewMockLogWriterCreator(ctrl)
+			gomock.InOrder(
+				writerCreator.EXPECT().Accept(&config1).Return(true),
+				writerCreator.EXPECT().Accept(&config2).Return(true),
+			)
+			gomock.InOrder(
+				writerCreator.EXPECT().Create(&config1).Return(writer1, nil),
+				writerCreator.EXPECT().Create(&config2).Return(writer2, nil),
+			)
+			writerFactory := NewLogWriterFactory([]LogWriterCreator{writerCreator})
+			log := NewLog()
+
+			sut, _ := NewLogLoader(config, log, writerFactory)
+			_ = sut.Load()
+			_ = config.AddPrepareHook(func(_, _ ConfigPartial) error {
+				return expected
+			})
+
+			if e := config.AddSupplier("supplier.2", 100, supplier2); !errors.Is(e, expected) {
+				t.Errorf("(%v) when expecting (%v)", e, expected)
+			} else if check, _ := log.Writer("id"); check != writer1 {
+				t.Error("didn't kept the current writer")
+			}
+		})
+
+		t.Run("log the config reload failures", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := fmt.Errorf("error message")
+			config1 := ConfigPartial{
+				"type":     "console",
+				"format":   "json",
+				"Channels": []interface{}{},
+				"Level":    "fatal",
+			}
+			partial := ConfigPartial{}
+			_, _ = partial.Set("slate.log.writers.id", config1)
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(partial, nil).Times(1)
+			config := NewConfig()
+			_ = config.AddSupplier("supplier", 1, supplier)
+			writer := NewMockLogWriter(ctrl)
+			writer.EXPECT().Signal(LogLoaderConfigErrorChannel, ERROR, "config reload failed", LogContext{"error": expected.Error()}).Return(nil).Times(1)
+			writerCreator := NewMockLogWriterCreator(ctrl)
+			writerCreator.EXPECT().Accept(&config1).Return(true).Times(1)
+			writerCreator.EXPECT().Create(&config1).Return(writer, nil).Times(1)
+			writerFactory := NewLogWriterFactory([]LogWriterCreator{writerCreator})
+
+			sut, _ := NewLogLoader(config, NewLog(), writerFactory)
+			_ = sut.Load()
+
+			if len(config.handlers) != 1 {
+				t.Errorf("registered (%d) config error handlers", len(config.handlers))
+			} else {
+				config.handlers[0](expected)
+			}
+		})
+
+		t.Run("log the config warnings", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expected := fmt.Errorf("warning message")
+			config1 := ConfigPartial{
+				"type":     "console",
+				"format":   "json",
+				"Channels": []interface{}{},
+				"Level":    "fatal",
+			}
+			partial := ConfigPartial{}
+			_, _ = partial.Set("slate.log.writers.id", config1)
+			supplier := NewMockConfigSupplier(ctrl)
+			supplier.EXPECT().Get("").Return(partial, nil).Times(1)
+			config := NewConfig()
+			_ = config.AddSupplier("supplier", 1, supplier)
+			config.warn(expected)
+			writer := NewMockLogWriter(ctrl)
+			writer.EXPECT().Signal(LogLoaderConfigErrorChannel, WARNING, "config warning", LogContext{"error": expected.Error()}).Return(nil).Times(1)
+			writerCreator := NewMockLogWriterCreator(ctrl)
+			writerCreator.EXPECT().Accept(&config1).Return(true).Times(1)
+			writerCreator.EXPECT().Create(&config1).Return(writer, nil).Times(1)
+			writerFactory := NewLogWriterFactory([]LogWriterCreator{writerCreator})
+
+			sut, _ := NewLogLoader(config, NewLog(), writerFactory)
+			_ = sut.Load()
+
+			if len(config.warners) != 1 {
+				t.Errorf("registered (%d) config warning handlers", len(config.warners))
+			}
+		})
+
+		t.Run("log the config changes", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			LogLoaderConfigChangeChannel = "changes"
+			defer func() { LogLoaderConfigChangeChannel = "" }()
+
+			config1 := ConfigPartial{
+				"type":     "console",
+				"format":   "json",
+				"Channels": []interface{}{},
+				"Level":    "fatal",
+			}
+			partial := ConfigPartial{}
+			_, _ = partial.Set("slate.log.writers.id", config1)
+			supplier1 := NewMockConfigSupplier(ctrl)
+			supplier1.EXPECT().Get("").Return(partial, nil).AnyTimes()
+			supplier2 := NewMockConfigSupplier(ctrl)
+			supplier2.EXPECT().Get("").Return(ConfigPartial{"node": "value"}, nil).AnyTimes()
+			config := NewConfig()
+			_ = config.AddSupplier("supplier1", 1, supplier1)
+			writer := NewMockLogWriter(ctrl)
+			writer.EXPECT().Signal("changes", INFO, "config changed", LogContext{
+				"revision": uint64(2),
+				"supplier": "supplier2",
+				"changes":  []ConfigChange{{Path: "node", Old: nil, New: "value"}},
+			}).Return(nil).Times(1)
+			writerCreator := NewMockLogWriterCreator(ctrl)
+			writerCreator.EXPECT().Accept(&config1).Return(true).Times(1)
+			writerCreator.EXPECT().Create(&config1).Return(writer, nil).Times(1)
+			writerFactory := NewLogWriterFactory([]LogWriterCreator{writerCreator})
+
+			sut, _ := NewLogLoader(config, NewLog(), writerFactory)
+			_ = sut.Load()
+			_ = config.AddSupplier("supplier2", 2, supplier2)
+		})
 	})
 }
 
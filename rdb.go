@@ -2,8 +2,11 @@ package slate
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
 )
 
@@ -52,6 +55,15 @@ const (
 	// Provider registration id of the primary relational database service.
 	RdbPrimaryConnectionContainerID = RdbConnectionContainerID + ".primary"
 
+	// RdbConfigSourceCreatorContainerID defines the id of a config
+	// supplier service that retrieves the config data from a relational
+	// database table.
+	RdbConfigSourceCreatorContainerID = ConfigSupplierCreatorTag + ".rdb"
+
+	// RdbConfigSupplierType defines the value to be used to declare a
+	// relational database table config supplier type.
+	RdbConfigSupplierType = "rdb"
+
 	// RdbEnvID defines the base environment variable name for all
 	// relational database related environment variables.
 	RdbEnvID = EnvID + "_RDB"
@@ -70,6 +82,19 @@ var (
 	// config entries list, so it can reset the connections pool as reconfigure
 	// the connections.
 	RdbObserveConfig = EnvBool(RdbEnvID+"_OBSERVE_CONFIG", true)
+
+	// RdbConfigTable defines the default table used by the relational
+	// database config suppliers.
+	RdbConfigTable = EnvString(RdbEnvID+"_CONFIG_TABLE", "__config")
+
+	// RdbConfigKeyColumn defines the default column that holds the config
+	// path of the rows read by the relational database config suppliers.
+	RdbConfigKeyColumn = EnvString(RdbEnvID+"_CONFIG_KEY_COLUMN", "key")
+
+	// RdbConfigValueColumn defines the default column that holds the
+	// config value of the rows read by the relational database config
+	// suppliers.
+	RdbConfigValueColumn = EnvString(RdbEnvID+"_CONFIG_VALUE_COLUMN", "value")
 )
 
 // ----------------------------------------------------------------------------
@@ -217,8 +242,9 @@ func NewRdbConnectionPool(
 	}
 	// check if is to observe connection configuration changes
 	if RdbObserveConfig {
-		// add an observer to the connections config
-		_ = config.AddObserver(RdbConnectionsConfigPath, func(_ interface{}, _ interface{}) {
+		// add an observer to the connections config, signaling the
+		// observer registration failure as a config warning
+		if _, e := config.AddObserver(RdbConnectionsConfigPath, func(_ interface{}, _ interface{}) {
 			// close all the currently opened connections
 			for _, conn := range pool.connections {
 				if db, e := conn.DB(); db != nil && e == nil {
@@ -227,7 +253,9 @@ func NewRdbConnectionPool(
 			}
 			// clear the storing pool
 			pool.connections = map[string]*gorm.DB{}
-		})
+		}); e != nil {
+			config.warn(e)
+		}
 	}
 	return pool, nil
 }
@@ -264,6 +292,343 @@ func (f *RdbConnectionPool) Get(
 	return conn, nil
 }
 
+// ----------------------------------------------------------------------------
+// rdb config source
+// ----------------------------------------------------------------------------
+
+// RdbConfigSource defines a config supplier that loads the configuration
+// from the rows of a relational database table. Each row key is mapped
+// as a config path that will store the row value or, if a format is
+// given, the parsed row value document (an empty key will mount the
+// document in the supplier root). The supplier is checked for updates by
+// the maximum value of the version column, or by the loaded content hash
+// if no version column is given.
+type RdbConfigSource struct {
+	ConfigSource
+	pool          *RdbConnectionPool
+	connection    string
+	gormConfig    *gorm.Config
+	table         string
+	keyColumn     string
+	valueColumn   string
+	versionColumn string
+	format        string
+	parserFactory *ConfigParserFactory
+	policy        string
+	revision      interface{}
+}
+
+var _ ConfigObsSupplier = &RdbConfigSource{}
+var _ ConfigRefreshSupplier = &RdbConfigSource{}
+
+// NewRdbConfigSource will instantiate a new configuration supplier
+// that will read a database table for its configuration info.
+func NewRdbConfigSource(
+	pool *RdbConnectionPool,
+	connection string,
+	gormConfig *gorm.Config,
+	table,
+	keyColumn,
+	valueColumn,
+	versionColumn,
+	format string,
+	parserFactory *ConfigParserFactory,
+) (*RdbConfigSource, error) {
+	// check pool argument reference
+	if pool == nil {
+		return nil, errNilPointer("pool")
+	}
+	// check parser factory argument reference
+	if parserFactory == nil {
+		return nil, errNilPointer("parserFactory")
+	}
+	// instantiates the config supplier
+	source := &RdbConfigSource{
+		ConfigSource:  *NewConfigSource(),
+		pool:          pool,
+		connection:    connection,
+		gormConfig:    gormConfig,
+		table:         table,
+		keyColumn:     keyColumn,
+		valueColumn:   valueColumn,
+		versionColumn: versionColumn,
+		format:        format,
+		parserFactory: parserFactory,
+	}
+	// load the config information from the database
+	if _, e := source.Reload(); e != nil {
+		return nil, e
+	}
+	return source, nil
+}
+
+// Reload will check if the table content has been updated, and, if so,
+// reload the supplier configuration content.
+func (s *RdbConfigSource) Reload() (bool, error) {
+	// retrieve the database connection
+	db, e := s.pool.Get(s.connection, s.gormConfig)
+	if e != nil {
+		return false, e
+	}
+	// retrieve the stored table revision
+	s.Mutex.Lock()
+	current := s.revision
+	s.Mutex.Unlock()
+	// check the table version before loading the table content
+	var revision interface{}
+	if s.versionColumn != "" {
+		if revision, e = s.version(db); e != nil {
+			return false, e
+		}
+		if current != nil && reflect.DeepEqual(current, revision) {
+			return false, nil
+		}
+	}
+	// load the table content
+	partial, e := s.load(db)
+	if e != nil {
+		return false, e
+	}
+	// check the content hash if there is no version column
+	if s.versionColumn == "" {
+		revision = configHash(partial)
+		if current != nil && current == revision {
+			return false, nil
+		}
+	}
+	// store the loaded config information and table revision
+	s.Mutex.Lock()
+	s.Partial = partial
+	s.revision = revision
+	s.Mutex.Unlock()
+	return true, nil
+}
+
+// Refresh will force the reload of the table content.
+func (s *RdbConfigSource) Refresh() error {
+	// discard the stored revision and reload the table content
+	s.Mutex.Lock()
+	s.revision = nil
+	s.Mutex.Unlock()
+	_, e := s.Reload()
+	return e
+}
+
+func (s *RdbConfigSource) setCase(
+	policy string,
+) error {
+	// reload the table content with the given case policy
+	s.policy = policy
+	return s.Refresh()
+}
+
+func (s *RdbConfigSource) version(
+	db *gorm.DB,
+) (interface{}, error) {
+	// retrieve the maximum value of the version column
+	var version interface{}
+	if e := db.
+		Table(s.table).
+		Select("MAX(?)", clause.Column{Name: s.versionColumn}).
+		Row().
+		Scan(&version); e != nil {
+		return nil, e
+	}
+	if typedVersion, ok := version.([]byte); ok {
+		return string(typedVersion), nil
+	}
+	return version, nil
+}
+
+func (s *RdbConfigSource) load(
+	db *gorm.DB,
+) (ConfigPartial, error) {
+	// retrieve the table rows sorted by key
+	rows, e := db.
+		Table(s.table).
+		Select("?, ?", clause.Column{Name: s.keyColumn}, clause.Column{Name: s.valueColumn}).
+		Order(clause.OrderByColumn{Column: clause.Column{Name: s.keyColumn}}).
+		Rows()
+	if e != nil {
+		return nil, e
+	}
+	defer func() { _ = rows.Close() }()
+	// store the row values in the mapped paths
+	partial := ConfigPartial{}
+	for rows.Next() {
+		var key string
+		var value interface{}
+		if e := rows.Scan(&key, &value); e != nil {
+			return nil, e
+		}
+		if typedValue, ok := value.([]byte); ok {
+			value = string(typedValue)
+		}
+		if e := s.store(&partial, configCaseKey(key, s.policy), value); e != nil {
+			return nil, e
+		}
+	}
+	return partial, rows.Err()
+}
+
+func (s *RdbConfigSource) store(
+	partial *ConfigPartial,
+	key string,
+	value interface{},
+) error {
+	// store the plain value if no document format was defined
+	if s.format == "" {
+		_, e := partial.Set(key, value)
+		return e
+	}
+	// parse the row document
+	content, ok := value.(string)
+	if !ok {
+		return errConversion(value, "string")
+	}
+	parser, e := s.parserFactory.Create(s.format, strings.NewReader(content))
+	if e != nil {
+		return e
+	}
+	document, e := configParse(parser, s.policy)
+	if e != nil {
+		return e
+	}
+	// mount the document in the row key path
+	if key == "" {
+		partial.Merge(*document)
+		return nil
+	}
+	_, e = partial.Set(key, *document)
+	return e
+}
+
+// ----------------------------------------------------------------------------
+// rdb config source creator
+// ----------------------------------------------------------------------------
+
+// RdbConfigSourceCreator defines a supplier creator used to instantiate
+// a database table config supplier. The connection pool is only retrieved
+// from the container when a supplier is created, so the pool isn't
+// instantiated before the configuration has been loaded.
+type RdbConfigSourceCreator struct {
+	container     *ServiceContainer
+	parserFactory *ConfigParserFactory
+}
+
+var _ ConfigSupplierCreator = &RdbConfigSourceCreator{}
+
+// NewRdbConfigSourceCreator instantiates a new database table config
+// supplier creator service.
+func NewRdbConfigSourceCreator(
+	container *ServiceContainer,
+	parserFactory *ConfigParserFactory,
+) (*RdbConfigSourceCreator, error) {
+	// check container argument reference
+	if container == nil {
+		return nil, errNilPointer("container")
+	}
+	// check parser factory argument reference
+	if parserFactory == nil {
+		return nil, errNilPointer("parserFactory")
+	}
+	// instantiate the strategy
+	return &RdbConfigSourceCreator{
+		container:     container,
+		parserFactory: parserFactory,
+	}, nil
+}
+
+// Accept will check if the requested supplier can be instantiated by this
+// creator by parsing the given config partial.
+func (s RdbConfigSourceCreator) Accept(
+	config *ConfigPartial,
+) bool {
+	// check the config argument reference
+	if config == nil {
+		return false
+	}
+	// retrieve the data from the configuration
+	sConfig := struct{ Type string }{}
+	if _, e := config.Populate("", &sConfig); e != nil {
+		return false
+	}
+	// return acceptance for the read config type
+	return sConfig.Type == RdbConfigSupplierType
+}
+
+// Create will instantiate the desired database table supplier instance.
+func (s RdbConfigSourceCreator) Create(
+	config *ConfigPartial,
+) (ConfigSupplier, error) {
+	// check the config argument reference
+	if config == nil {
+		return nil, errNilPointer("config")
+	}
+	// retrieve the data from the configuration
+	sConfig := struct {
+		Connection string
+		Table      string
+		Format     string
+		Column     struct {
+			Key     string
+			Value   string
+			Version string
+		}
+	}{
+		Connection: RdbPrimary,
+		Table:      RdbConfigTable,
+	}
+	sConfig.Column.Key = RdbConfigKeyColumn
+	sConfig.Column.Value = RdbConfigValueColumn
+	if _, e := config.Populate("", &sConfig); e != nil {
+		return nil, e
+	}
+	// retrieve the connection pool and connection configuration
+	pool, gormConfig, e := s.pool()
+	if e != nil {
+		return nil, e
+	}
+	// create the database table config supplier
+	source, e := NewRdbConfigSource(
+		pool,
+		sConfig.Connection,
+		gormConfig,
+		sConfig.Table,
+		sConfig.Column.Key,
+		sConfig.Column.Value,
+		sConfig.Column.Version,
+		sConfig.Format,
+		s.parserFactory,
+	)
+	if e != nil {
+		return nil, e
+	}
+	return source, nil
+}
+
+func (s RdbConfigSourceCreator) pool() (*RdbConnectionPool, *gorm.Config, error) {
+	// retrieve the connection pool from the container
+	entry, e := s.container.Get(RdbContainerID)
+	if e != nil {
+		return nil, nil, e
+	}
+	pool, ok := entry.(*RdbConnectionPool)
+	if !ok {
+		return nil, nil, errConversion(entry, "*RdbConnectionPool")
+	}
+	// retrieve the connection configuration from the container
+	entry, e = s.container.Get(RdbConfigContainerID)
+	if e != nil {
+		return nil, nil, e
+	}
+	gormConfig, ok := entry.(*gorm.Config)
+	if !ok {
+		return nil, nil, errConversion(entry, "*gorm.Config")
+	}
+	return pool, gormConfig, nil
+}
+
 // ----------------------------------------------------------------------------
 // rdb service register
 // ----------------------------------------------------------------------------
@@ -302,6 +667,7 @@ func (sr RdbServiceRegister) Provide(
 	_ = container.Add(RdbConnectionFactoryContainerID, NewRdbConnectionFactory)
 	_ = container.Add(RdbContainerID, NewRdbConnectionPool)
 	_ = container.Add(RdbPrimaryConnectionContainerID, sr.getPrimaryConnection())
+	_ = container.Add(RdbConfigSourceCreatorContainerID, sr.getConfigSourceCreator(container), ConfigSupplierCreatorTag)
 	return nil
 }
 
@@ -329,6 +695,14 @@ func (RdbServiceRegister) getDialectCreators(
 	}
 }
 
+func (RdbServiceRegister) getConfigSourceCreator(
+	container *ServiceContainer,
+) func(parserFactory *ConfigParserFactory) (*RdbConfigSourceCreator, error) {
+	return func(parserFactory *ConfigParserFactory) (*RdbConfigSourceCreator, error) {
+		return NewRdbConfigSourceCreator(container, parserFactory)
+	}
+}
+
 func (RdbServiceRegister) getPrimaryConnection() func(pool *RdbConnectionPool, config *gorm.Config) (*gorm.DB, error) {
 	return func(pool *RdbConnectionPool, config *gorm.Config) (*gorm.DB, error) {
 		return pool.Get(RdbPrimary, config)
@@ -4,11 +4,15 @@ package slate
 
 import (
 	"errors"
+	"reflect"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
 )
 
 func Test_RdbSqliteDialectCreator(t *testing.T) {
@@ -221,3 +225,155 @@ func Test_RdbSqliteServiceRegister(t *testing.T) {
 		})
 	})
 }
+
+func Test_RdbConfigSource_Sqlite(t *testing.T) {
+	gormConfig := &gorm.Config{Logger: logger.Discard}
+	parserFactory := NewConfigParserFactory([]ConfigParserCreator{
+		NewConfigYAMLDecoderCreator(),
+		NewConfigJSONDecoderCreator(),
+	})
+	pool := func(name string) *RdbConnectionPool {
+		config := NewConfig()
+		partial := ConfigPartial{}
+		_, _ = partial.Set(RdbConnectionsConfigPath+".primary", ConfigPartial{
+			"dialect": "sqlite",
+			"host":    "file:" + name + "?mode=memory&cache=shared",
+		})
+		_ = config.AddSupplier("config", 0, &ConfigSource{Mutex: &sync.Mutex{}, Partial: partial})
+		connectionFactory, _ := NewRdbConnectionFactory(NewRdbDialectFactory([]RdbDialectCreator{NewRdbSqliteDialectCreator()}))
+		pool, _ := NewRdbConnectionPool(config, connectionFactory)
+		return pool
+	}
+	db := func(pool *RdbConnectionPool, statements ...string) {
+		conn, _ := pool.Get("primary", gormConfig)
+		for _, statement := range statements {
+			if e := conn.Exec(statement).Error; e != nil {
+				t.Fatalf("unexpected (%v) error executing (%s)", e, statement)
+			}
+		}
+	}
+
+	t.Run("error on missing table", func(t *testing.T) {
+		sut, e := NewRdbConfigSource(pool("missing"), "primary", gormConfig, "config", "key", "value", "", "", parserFactory)
+		switch {
+		case sut != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		}
+	})
+
+	t.Run("map the dotted keys into the supplier content", func(t *testing.T) {
+		p := pool("keys")
+		db(p,
+			"CREATE TABLE config (key TEXT, value TEXT)",
+			"INSERT INTO config VALUES ('Node.Field', 'value'), ('node.sub.field', '1'), ('other', '5')",
+		)
+
+		sut, e := NewRdbConfigSource(p, "primary", gormConfig, "config", "key", "value", "", "", parserFactory)
+		switch {
+		case e != nil:
+			t.Errorf("unexpected (%v) error", e)
+		case !reflect.DeepEqual(sut.Partial, ConfigPartial{
+			"node":  ConfigPartial{"field": "value", "sub": ConfigPartial{"field": "1"}},
+			"other": "5",
+		}):
+			t.Errorf("loaded the (%v) content", sut.Partial)
+		}
+	})
+
+	t.Run("parse the document rows", func(t *testing.T) {
+		p := pool("documents")
+		db(p,
+			"CREATE TABLE config (name TEXT, document TEXT)",
+			`INSERT INTO config VALUES ('', '{"field": "value"}'), ('node', '{"sub": {"field": 1}}')`,
+		)
+
+		sut, e := NewRdbConfigSource(p, "primary", gormConfig, "config", "name", "document", "", ConfigFormatJSON, parserFactory)
+		switch {
+		case e != nil:
+			t.Errorf("unexpected (%v) error", e)
+		case !reflect.DeepEqual(sut.Partial, ConfigPartial{
+			"field": "value",
+			"node":  ConfigPartial{"sub": ConfigPartial{"field": 1}},
+		}):
+			t.Errorf("loaded the (%v) content", sut.Partial)
+		}
+	})
+
+	t.Run("error on invalid document", func(t *testing.T) {
+		p := pool("invalid")
+		db(p,
+			"CREATE TABLE config (key TEXT, value TEXT)",
+			"INSERT INTO config VALUES ('node', '{')",
+		)
+
+		if _, e := NewRdbConfigSource(p, "primary", gormConfig, "config", "key", "value", "", ConfigFormatJSON, parserFactory); e == nil {
+			t.Error("didn't returned the expected error")
+		}
+	})
+
+	t.Run("reload on version change", func(t *testing.T) {
+		p := pool("version")
+		db(p,
+			"CREATE TABLE config (key TEXT, value TEXT, version INTEGER)",
+			"INSERT INTO config VALUES ('node', 'value1', 1)",
+		)
+		sut, _ := NewRdbConfigSource(p, "primary", gormConfig, "config", "key", "value", "version", "", parserFactory)
+
+		if reloaded, e := sut.Reload(); e != nil {
+			t.Errorf("unexpected (%v) error", e)
+		} else if reloaded {
+			t.Error("reloaded an unchanged table")
+		}
+		db(p, "UPDATE config SET value = 'value2', version = 2")
+		if reloaded, e := sut.Reload(); e != nil {
+			t.Errorf("unexpected (%v) error", e)
+		} else if !reloaded {
+			t.Error("didn't reloaded a changed table")
+		} else if check, _ := sut.Get("node"); check != "value2" {
+			t.Errorf("loaded the (%v) value", check)
+		}
+	})
+
+	t.Run("reload on content change without version column", func(t *testing.T) {
+		p := pool("hash")
+		db(p,
+			"CREATE TABLE config (key TEXT, value TEXT)",
+			"INSERT INTO config VALUES ('node', 'value1')",
+		)
+		sut, _ := NewRdbConfigSource(p, "primary", gormConfig, "config", "key", "value", "", "", parserFactory)
+
+		if reloaded, _ := sut.Reload(); reloaded {
+			t.Error("reloaded an unchanged table")
+		}
+		db(p, "UPDATE config SET value = 'value2'")
+		if reloaded, _ := sut.Reload(); !reloaded {
+			t.Error("didn't reloaded a changed table")
+		} else if e := sut.Refresh(); e != nil {
+			t.Errorf("unexpected (%v) error", e)
+		} else if check, _ := sut.Get("node"); check != "value2" {
+			t.Errorf("loaded the (%v) value", check)
+		}
+	})
+
+	t.Run("create the supplier from the loader definition", func(t *testing.T) {
+		p := pool("creator")
+		db(p,
+			"CREATE TABLE __config (key TEXT, value TEXT)",
+			"INSERT INTO __config VALUES ('node', 'value')",
+		)
+		container := NewServiceContainer()
+		_ = container.Add(RdbContainerID, func() *RdbConnectionPool { return p })
+		_ = container.Add(RdbConfigContainerID, func() *gorm.Config { return gormConfig })
+		creator, _ := NewRdbConfigSourceCreator(container, parserFactory)
+
+		sut, e := creator.Create(&ConfigPartial{"type": RdbConfigSupplierType})
+		switch {
+		case e != nil:
+			t.Errorf("unexpected (%v) error", e)
+		case !sut.Has("node"):
+			t.Error("didn't loaded the table content")
+		}
+	})
+}
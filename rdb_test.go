@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
@@ -278,6 +279,17 @@ func Test_RdbConnectionPool(t *testing.T) {
 			}
 		})
 
+		t.Run("warn on observer registration failure", func(t *testing.T) {
+			connectionsFactory, _ := NewRdbConnectionFactory(NewRdbDialectFactory(nil))
+			config := NewConfig()
+
+			if _, e := NewRdbConnectionPool(config, connectionsFactory); e != nil {
+				t.Errorf("return the unexpected error : %v", e)
+			} else if warnings := config.Warnings(); len(warnings) != 1 || !errors.Is(warnings[0], ErrConfigPathNotFound) {
+				t.Errorf("recorded the (%v) warnings", warnings)
+			}
+		})
+
 		t.Run("config change purge all stored connections", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
@@ -496,6 +508,174 @@ func Test_RdbConnectionPool(t *testing.T) {
 	})
 }
 
+func Test_RdbConfigSource(t *testing.T) {
+	t.Run("NewRdbConfigSource", func(t *testing.T) {
+		t.Run("nil pool", func(t *testing.T) {
+			sut, e := NewRdbConfigSource(nil, "primary", nil, "table", "key", "value", "", "", NewConfigParserFactory(nil))
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("nil parser factory", func(t *testing.T) {
+			pool, _ := NewRdbConnectionPool(NewConfig(), &RdbConnectionFactory{})
+			sut, e := NewRdbConfigSource(pool, "primary", nil, "table", "key", "value", "", "", nil)
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("error retrieving the connection", func(t *testing.T) {
+			pool, _ := NewRdbConnectionPool(NewConfig(), &RdbConnectionFactory{})
+			sut, e := NewRdbConfigSource(pool, "primary", nil, "table", "key", "value", "", "", NewConfigParserFactory(nil))
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrConfigPathNotFound):
+				t.Errorf("(%v) when expecting (%v)", e, ErrConfigPathNotFound)
+			}
+		})
+	})
+}
+
+func Test_RdbConfigSourceCreator(t *testing.T) {
+	t.Run("NewRdbConfigSourceCreator", func(t *testing.T) {
+		t.Run("nil container", func(t *testing.T) {
+			sut, e := NewRdbConfigSourceCreator(nil, NewConfigParserFactory(nil))
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("nil parser factory", func(t *testing.T) {
+			sut, e := NewRdbConfigSourceCreator(NewServiceContainer(), nil)
+			switch {
+			case sut != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+	})
+
+	t.Run("Accept", func(t *testing.T) {
+		sut, _ := NewRdbConfigSourceCreator(NewServiceContainer(), NewConfigParserFactory(nil))
+
+		t.Run("don't accept if config is a nil pointer", func(t *testing.T) {
+			if sut.Accept(nil) {
+				t.Error("returned true")
+			}
+		})
+
+		t.Run("don't accept if type is not a string", func(t *testing.T) {
+			if sut.Accept(&ConfigPartial{"type": 123}) {
+				t.Error("returned true")
+			}
+		})
+
+		t.Run("don't accept if type is not rdb", func(t *testing.T) {
+			if sut.Accept(&ConfigPartial{"type": ConfigTypeFile}) {
+				t.Error("returned true")
+			}
+		})
+
+		t.Run("accept rdb type", func(t *testing.T) {
+			if !sut.Accept(&ConfigPartial{"type": RdbConfigSupplierType}) {
+				t.Error("returned false")
+			}
+		})
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		pool, _ := NewRdbConnectionPool(NewConfig(), &RdbConnectionFactory{})
+		container := NewServiceContainer()
+		_ = container.Add(RdbContainerID, func() *RdbConnectionPool { return pool })
+		_ = container.Add(RdbConfigContainerID, func() *gorm.Config { return &gorm.Config{Logger: logger.Discard} })
+		sut, _ := NewRdbConfigSourceCreator(container, NewConfigParserFactory(nil))
+
+		t.Run("nil config", func(t *testing.T) {
+			src, e := sut.Create(nil)
+			switch {
+			case src != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrNilPointer):
+				t.Errorf("(%v) when expecting (%v)", e, ErrNilPointer)
+			}
+		})
+
+		t.Run("non-string table", func(t *testing.T) {
+			src, e := sut.Create(&ConfigPartial{"type": RdbConfigSupplierType, "table": 123})
+			switch {
+			case src != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrConversion):
+				t.Errorf("(%v) when expecting (%v)", e, ErrConversion)
+			}
+		})
+
+		t.Run("error retrieving the pool", func(t *testing.T) {
+			sut, _ := NewRdbConfigSourceCreator(NewServiceContainer(), NewConfigParserFactory(nil))
+
+			if src, e := sut.Create(&ConfigPartial{"type": RdbConfigSupplierType}); src != nil {
+				t.Error("returned a valid reference")
+			} else if e == nil {
+				t.Error("didn't returned the expected error")
+			}
+		})
+
+		t.Run("error on non-pool service", func(t *testing.T) {
+			container := NewServiceContainer()
+			_ = container.Add(RdbContainerID, func() string { return "pool" })
+			sut, _ := NewRdbConfigSourceCreator(container, NewConfigParserFactory(nil))
+
+			src, e := sut.Create(&ConfigPartial{"type": RdbConfigSupplierType})
+			switch {
+			case src != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrConversion):
+				t.Errorf("(%v) when expecting (%v)", e, ErrConversion)
+			}
+		})
+
+		t.Run("error retrieving the connection", func(t *testing.T) {
+			src, e := sut.Create(&ConfigPartial{"type": RdbConfigSupplierType, "connection": "other"})
+			switch {
+			case src != nil:
+				t.Error("returned a valid reference")
+			case e == nil:
+				t.Error("didn't returned the expected error")
+			case !errors.Is(e, ErrConfigPathNotFound):
+				t.Errorf("(%v) when expecting (%v)", e, ErrConfigPathNotFound)
+			}
+		})
+	})
+}
+
 func Test_RdbServiceRegister(t *testing.T) {
 	t.Run("NewRdbServiceRegister", func(t *testing.T) {
 		t.Run("create", func(t *testing.T) {
@@ -543,6 +723,40 @@ func Test_RdbServiceRegister(t *testing.T) {
 				t.Errorf("no connection pool : %v", sut)
 			case !container.Has(RdbPrimaryConnectionContainerID):
 				t.Errorf("no primary connection handler : %v", sut)
+			case !container.Has(RdbConfigSourceCreatorContainerID):
+				t.Errorf("no config supplier creator : %v", sut)
+			}
+		})
+
+		t.Run("don't create the connection pool while loading the config", func(t *testing.T) {
+			prev := ConfigLoaderSupplierFormat
+			ConfigLoaderSupplierFormat = ConfigFormatYAML
+			defer func() { ConfigLoaderSupplierFormat = prev }()
+
+			fileSystem := afero.NewMemMapFs()
+			_ = afero.WriteFile(fileSystem, ConfigLoaderFileSupplierPath, []byte(`
+slate:
+  rdb:
+    connections:
+      primary:
+        dialect: sqlite
+        host: ":memory:"
+`), 0o644)
+			container := NewServiceContainer()
+			_ = container.Add(FileSystemContainerID, func() afero.Fs { return fileSystem })
+			_ = NewConfigServiceRegister().Provide(container)
+			_ = NewRdbServiceRegister().Provide(container)
+
+			if e := NewConfigServiceRegister().Boot(container); e != nil {
+				t.Errorf("unexpected error (%v)", e)
+				return
+			}
+			entry, _ := container.Get(ConfigContainerID)
+			config := entry.(*Config)
+			if _, e := container.Get(RdbContainerID); e != nil {
+				t.Errorf("unexpected error (%v)", e)
+			} else if !config.HasObserver(RdbConnectionsConfigPath) {
+				t.Error("didn't registered the connections config observer")
 			}
 		})
 